@@ -0,0 +1,197 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"database/sql"
+	"iter"
+	"math/big"
+	"slices"
+)
+
+// Integer is the set of nullable integer types that can be summed and
+// averaged.
+type Integer interface {
+	Int64 | Int32 | Int16
+}
+
+// Sum returns the sum of the non-NULL values like the SQL SUM() aggregate,
+// or NULL if there are no non-NULL values. The sum is always an Int64, and
+// ErrOverflow is returned if it does not fit in one.
+func Sum[T Integer](values []T) (Int64, error) {
+	return SumSeq(slices.Values(values))
+}
+
+// SumSeq is like Sum but consumes a sequence.
+func SumSeq[T Integer](seq iter.Seq[T]) (Int64, error) {
+	var sum Int64
+
+	for v := range seq {
+		n, ok := integerValue(v)
+		if !ok {
+			continue
+		}
+		if !sum.Valid {
+			sum = NewInt64(n)
+			continue
+		}
+
+		var err error
+		if sum, err = sum.CheckedAdd(NewInt64(n)); err != nil {
+			return Int64{}, err
+		}
+	}
+
+	return sum, nil
+}
+
+// Avg returns the arithmetic mean of the non-NULL values like the SQL AVG()
+// aggregate, or NULL if there are no non-NULL values. Like AVG() over an
+// integer column, the sum is kept exact, falling back to arbitrary precision
+// if it overflows an int64, and is divided only once at the end, so the mean
+// is the float64 nearest to the exact result.
+func Avg[T Integer](values []T) sql.NullFloat64 {
+	return AvgSeq(slices.Values(values))
+}
+
+// AvgSeq is like Avg but consumes a sequence.
+func AvgSeq[T Integer](seq iter.Seq[T]) sql.NullFloat64 {
+	var sum int64
+	var wide *big.Int
+	var count int64
+
+	for v := range seq {
+		n, ok := integerValue(v)
+		if !ok {
+			continue
+		}
+		count++
+
+		if wide != nil {
+			wide.Add(wide, big.NewInt(n))
+			continue
+		}
+		res, err := add(sum, n)
+		if err != nil {
+			wide = new(big.Int).Add(big.NewInt(sum), big.NewInt(n))
+			continue
+		}
+		sum = res
+	}
+
+	if count == 0 {
+		return sql.NullFloat64{}
+	}
+	if wide == nil {
+		wide = big.NewInt(sum)
+	}
+	mean, _ := new(big.Rat).SetFrac(wide, big.NewInt(count)).Float64()
+
+	return sql.NullFloat64{Float64: mean, Valid: true}
+}
+
+// Min returns the smallest non-NULL value like the SQL MIN() aggregate, or
+// NULL if there are no non-NULL values.
+func Min[T Ordered[T]](values []T) T {
+	return MinSeq(slices.Values(values))
+}
+
+// MinSeq is like Min but consumes a sequence.
+func MinSeq[T Ordered[T]](seq iter.Seq[T]) T {
+	return extremum(seq, -1)
+}
+
+// Max returns the largest non-NULL value like the SQL MAX() aggregate, or
+// NULL if there are no non-NULL values.
+func Max[T Ordered[T]](values []T) T {
+	return MaxSeq(slices.Values(values))
+}
+
+// MaxSeq is like Max but consumes a sequence.
+func MaxSeq[T Ordered[T]](seq iter.Seq[T]) T {
+	return extremum(seq, 1)
+}
+
+// Count returns the number of non-NULL values like COUNT(column) in SQL.
+func Count[T Nullable](values []T) int {
+	return CountSeq(slices.Values(values))
+}
+
+// CountSeq is like Count but consumes a sequence.
+func CountSeq[T Nullable](seq iter.Seq[T]) int {
+	var count int
+
+	for v := range seq {
+		if !v.Null() {
+			count++
+		}
+	}
+
+	return count
+}
+
+// CountAll returns the number of values including NULLs like COUNT(*) in SQL.
+func CountAll[T any](values []T) int {
+	return len(values)
+}
+
+// CountAllSeq is like CountAll but consumes a sequence.
+func CountAllSeq[T any](seq iter.Seq[T]) int {
+	var count int
+
+	for range seq {
+		count++
+	}
+
+	return count
+}
+
+// extremum returns the non-NULL value of seq that compares in the direction
+// of sign against all others, or the zero value, which is NULL, if there is
+// none.
+func extremum[T Ordered[T]](seq iter.Seq[T], sign int) T {
+	var res T
+	var found bool
+
+	for v := range seq {
+		if v.Null() {
+			continue
+		}
+		if !found || v.Compare(res)*sign > 0 {
+			res, found = v, true
+		}
+	}
+
+	return res
+}
+
+func integerValue[T Integer](value T) (int64, bool) {
+	switch v := any(value).(type) {
+	case Int64:
+		return v.Int64, v.Valid
+	case Int32:
+		return int64(v.Int32), v.Valid
+	case Int16:
+		return int64(v.Int16), v.Valid
+	}
+
+	return 0, false
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"database/sql"
+	"errors"
+	"maps"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSum(t *testing.T) {
+	testCases := []struct {
+		label   string
+		input   []Int64
+		want    Int64
+		wantErr error
+	}{
+		{"with no values", nil, Int64{}, nil},
+		{"with only NULL values", []Int64{{}, {Int64: 5}}, Int64{}, nil},
+		{"with mixed values", []Int64{NewInt64(1), {Int64: 5}, NewInt64(-3)}, NewInt64(-2), nil},
+		{"with single zero", []Int64{{}, NewInt64(0)}, NewInt64(0), nil},
+		{"with sum at maximum", []Int64{NewInt64(math.MaxInt64 - 1), NewInt64(1)}, NewInt64(math.MaxInt64), nil},
+		{"with overflow", []Int64{NewInt64(math.MaxInt64), NewInt64(1)}, Int64{}, ErrOverflow},
+		{"with underflow", []Int64{NewInt64(math.MinInt64), NewInt64(-1)}, Int64{}, ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := Sum(tc.input)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestSumWidensSmallIntegers(t *testing.T) {
+	res, err := Sum([]Int32{NewInt32(math.MaxInt32), NewInt32(math.MaxInt32), {}})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if want := NewInt64(2 * math.MaxInt32); res != want {
+		t.Errorf("got: %v, want: %v", res, want)
+		return
+	}
+
+	res, err = SumSeq(slices.Values([]Int16{NewInt16(math.MinInt16), NewInt16(math.MinInt16)}))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if want := NewInt64(2 * math.MinInt16); res != want {
+		t.Errorf("got: %v, want: %v", res, want)
+		return
+	}
+}
+
+func TestAvg(t *testing.T) {
+	testCases := []struct {
+		label string
+		input []Int64
+		want  sql.NullFloat64
+	}{
+		{"with no values", nil, sql.NullFloat64{}},
+		{"with only NULL values", []Int64{{}, {Int64: 5}}, sql.NullFloat64{}},
+		{"with mixed values", []Int64{NewInt64(1), {Int64: 5}, NewInt64(2)}, sql.NullFloat64{Float64: 1.5, Valid: true}},
+		{"with values that overflow a sum", []Int64{NewInt64(math.MaxInt64), NewInt64(math.MaxInt64)}, sql.NullFloat64{Float64: math.MaxInt64, Valid: true}},
+		{"with a sum that overflows and recovers", []Int64{NewInt64(math.MaxInt64), NewInt64(math.MaxInt64), NewInt64(-math.MaxInt64), NewInt64(1)}, sql.NullFloat64{Float64: (math.MaxInt64 + 1) / 4, Valid: true}},
+		{"with a sum beyond 2^53", []Int64{NewInt64(1 << 53), NewInt64(1), NewInt64(1)}, sql.NullFloat64{Float64: 3002399751580331.5, Valid: true}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := Avg(tc.input); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	testCases := []struct {
+		label   string
+		input   []Int16
+		wantMin Int16
+		wantMax Int16
+	}{
+		{"with no values", nil, Int16{}, Int16{}},
+		{"with only NULL values", []Int16{{}, {Int16: 5}}, Int16{}, Int16{}},
+		{"with single value", []Int16{{}, NewInt16(5)}, NewInt16(5), NewInt16(5)},
+		{"with mixed values", []Int16{NewInt16(3), {}, NewInt16(-1), {Int16: -9}, NewInt16(7)}, NewInt16(-1), NewInt16(7)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := Min(tc.input); res != tc.wantMin {
+				t.Errorf("got: %v, want: %v", res, tc.wantMin)
+				return
+			}
+			if res := Max(tc.input); res != tc.wantMax {
+				t.Errorf("got: %v, want: %v", res, tc.wantMax)
+				return
+			}
+		})
+	}
+}
+
+func TestMinMaxTime(t *testing.T) {
+	base := time.Date(2012, 12, 12, 12, 12, 12, 0, time.UTC)
+	rows := map[string]Time{
+		"a": NewTime(base.Add(time.Hour)),
+		"b": {},
+		"c": NewTime(base),
+		"d": NewTime(base.Add(-time.Hour)),
+	}
+
+	if res := MinSeq(maps.Values(rows)); !res.Valid || !res.Time.Equal(base.Add(-time.Hour)) {
+		t.Errorf("got: %v, want: %v", res, base.Add(-time.Hour))
+		return
+	}
+	if res := MaxSeq(maps.Values(rows)); !res.Valid || !res.Time.Equal(base.Add(time.Hour)) {
+		t.Errorf("got: %v, want: %v", res, base.Add(time.Hour))
+		return
+	}
+}
+
+func TestCount(t *testing.T) {
+	testCases := []struct {
+		label        string
+		input        []String
+		wantCount    int
+		wantCountAll int
+	}{
+		{"with no values", nil, 0, 0},
+		{"with only NULL values", []String{{}, {String: "stale"}}, 0, 2},
+		{"with mixed values", []String{NewString(""), {}, NewString("a")}, 2, 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := Count(tc.input); res != tc.wantCount {
+				t.Errorf("got: %v, want: %v", res, tc.wantCount)
+				return
+			}
+			if res := CountAll(tc.input); res != tc.wantCountAll {
+				t.Errorf("got: %v, want: %v", res, tc.wantCountAll)
+				return
+			}
+			if res := CountAllSeq(slices.Values(tc.input)); res != tc.wantCountAll {
+				t.Errorf("got: %v, want: %v", res, tc.wantCountAll)
+				return
+			}
+		})
+	}
+}
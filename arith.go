@@ -0,0 +1,429 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import "errors"
+
+var (
+	// ErrOverflow is returned by the checked arithmetic methods when the
+	// result does not fit in the underlying integer type.
+	ErrOverflow = errors.New("nullable: integer overflow")
+
+	// ErrDivisionByZero is returned by the division methods when the divisor
+	// is zero, mirroring the error raised by SQL databases.
+	ErrDivisionByZero = errors.New("nullable: division by zero")
+)
+
+// Add returns the sum of i and other, or NULL if either value is NULL. Like
+// the built-in operator, the result wraps around on overflow. Use CheckedAdd
+// to detect overflow instead.
+func (i Int64) Add(other Int64) Int64 {
+	res, _ := i.apply(other, wrapping(add[int64]))
+
+	return res
+}
+
+// Sub returns the difference of i and other, or NULL if either value is
+// NULL. The result wraps around on overflow.
+func (i Int64) Sub(other Int64) Int64 {
+	res, _ := i.apply(other, wrapping(sub[int64]))
+
+	return res
+}
+
+// Mul returns the product of i and other, or NULL if either value is NULL.
+// The result wraps around on overflow.
+func (i Int64) Mul(other Int64) Int64 {
+	res, _ := i.apply(other, wrapping(mul[int64]))
+
+	return res
+}
+
+// Div returns the quotient of i and other truncated towards zero, or NULL if
+// either value is NULL. Dividing a non-NULL value by zero returns
+// ErrDivisionByZero rather than panicking. Dividing the smallest int64 by -1
+// wraps around, use CheckedDiv to detect it.
+func (i Int64) Div(other Int64) (Int64, error) {
+	return i.apply(other, wrapping(div[int64]))
+}
+
+// Mod returns the remainder of i divided by other, which has the sign of i,
+// or NULL if either value is NULL. Dividing a non-NULL value by zero returns
+// ErrDivisionByZero rather than panicking.
+func (i Int64) Mod(other Int64) (Int64, error) {
+	return i.apply(other, mod[int64])
+}
+
+// Neg returns the negation of i, or NULL if i is NULL. Negating the smallest
+// int64 wraps around to itself.
+func (i Int64) Neg() Int64 {
+	res, _ := i.apply(NewInt64(0), wrapping(neg[int64]))
+
+	return res
+}
+
+// Abs returns the absolute value of i, or NULL if i is NULL. The absolute
+// value of the smallest int64 wraps around to itself.
+func (i Int64) Abs() Int64 {
+	res, _ := i.apply(NewInt64(0), wrapping(abs[int64]))
+
+	return res
+}
+
+// CheckedAdd is like Add but returns ErrOverflow if the result overflows.
+func (i Int64) CheckedAdd(other Int64) (Int64, error) {
+	return i.apply(other, add[int64])
+}
+
+// CheckedSub is like Sub but returns ErrOverflow if the result overflows.
+func (i Int64) CheckedSub(other Int64) (Int64, error) {
+	return i.apply(other, sub[int64])
+}
+
+// CheckedMul is like Mul but returns ErrOverflow if the result overflows.
+func (i Int64) CheckedMul(other Int64) (Int64, error) {
+	return i.apply(other, mul[int64])
+}
+
+// CheckedDiv is like Div but returns ErrOverflow if the result overflows.
+func (i Int64) CheckedDiv(other Int64) (Int64, error) {
+	return i.apply(other, div[int64])
+}
+
+// CheckedNeg is like Neg but returns ErrOverflow if the result overflows.
+func (i Int64) CheckedNeg() (Int64, error) {
+	return i.apply(NewInt64(0), neg[int64])
+}
+
+// CheckedAbs is like Abs but returns ErrOverflow if the result overflows.
+func (i Int64) CheckedAbs() (Int64, error) {
+	return i.apply(NewInt64(0), abs[int64])
+}
+
+// Add returns the sum of i and other, or NULL if either value is NULL. Like
+// the built-in operator, the result wraps around on overflow. Use CheckedAdd
+// to detect overflow instead.
+func (i Int32) Add(other Int32) Int32 {
+	res, _ := i.apply(other, wrapping(add[int32]))
+
+	return res
+}
+
+// Sub returns the difference of i and other, or NULL if either value is
+// NULL. The result wraps around on overflow.
+func (i Int32) Sub(other Int32) Int32 {
+	res, _ := i.apply(other, wrapping(sub[int32]))
+
+	return res
+}
+
+// Mul returns the product of i and other, or NULL if either value is NULL.
+// The result wraps around on overflow.
+func (i Int32) Mul(other Int32) Int32 {
+	res, _ := i.apply(other, wrapping(mul[int32]))
+
+	return res
+}
+
+// Div returns the quotient of i and other truncated towards zero, or NULL if
+// either value is NULL. Dividing a non-NULL value by zero returns
+// ErrDivisionByZero rather than panicking. Dividing the smallest int32 by -1
+// wraps around, use CheckedDiv to detect it.
+func (i Int32) Div(other Int32) (Int32, error) {
+	return i.apply(other, wrapping(div[int32]))
+}
+
+// Mod returns the remainder of i divided by other, which has the sign of i,
+// or NULL if either value is NULL. Dividing a non-NULL value by zero returns
+// ErrDivisionByZero rather than panicking.
+func (i Int32) Mod(other Int32) (Int32, error) {
+	return i.apply(other, mod[int32])
+}
+
+// Neg returns the negation of i, or NULL if i is NULL. Negating the smallest
+// int32 wraps around to itself.
+func (i Int32) Neg() Int32 {
+	res, _ := i.apply(NewInt32(0), wrapping(neg[int32]))
+
+	return res
+}
+
+// Abs returns the absolute value of i, or NULL if i is NULL. The absolute
+// value of the smallest int32 wraps around to itself.
+func (i Int32) Abs() Int32 {
+	res, _ := i.apply(NewInt32(0), wrapping(abs[int32]))
+
+	return res
+}
+
+// CheckedAdd is like Add but returns ErrOverflow if the result overflows.
+func (i Int32) CheckedAdd(other Int32) (Int32, error) {
+	return i.apply(other, add[int32])
+}
+
+// CheckedSub is like Sub but returns ErrOverflow if the result overflows.
+func (i Int32) CheckedSub(other Int32) (Int32, error) {
+	return i.apply(other, sub[int32])
+}
+
+// CheckedMul is like Mul but returns ErrOverflow if the result overflows.
+func (i Int32) CheckedMul(other Int32) (Int32, error) {
+	return i.apply(other, mul[int32])
+}
+
+// CheckedDiv is like Div but returns ErrOverflow if the result overflows.
+func (i Int32) CheckedDiv(other Int32) (Int32, error) {
+	return i.apply(other, div[int32])
+}
+
+// CheckedNeg is like Neg but returns ErrOverflow if the result overflows.
+func (i Int32) CheckedNeg() (Int32, error) {
+	return i.apply(NewInt32(0), neg[int32])
+}
+
+// CheckedAbs is like Abs but returns ErrOverflow if the result overflows.
+func (i Int32) CheckedAbs() (Int32, error) {
+	return i.apply(NewInt32(0), abs[int32])
+}
+
+// Add returns the sum of i and other, or NULL if either value is NULL. Like
+// the built-in operator, the result wraps around on overflow. Use CheckedAdd
+// to detect overflow instead.
+func (i Int16) Add(other Int16) Int16 {
+	res, _ := i.apply(other, wrapping(add[int16]))
+
+	return res
+}
+
+// Sub returns the difference of i and other, or NULL if either value is
+// NULL. The result wraps around on overflow.
+func (i Int16) Sub(other Int16) Int16 {
+	res, _ := i.apply(other, wrapping(sub[int16]))
+
+	return res
+}
+
+// Mul returns the product of i and other, or NULL if either value is NULL.
+// The result wraps around on overflow.
+func (i Int16) Mul(other Int16) Int16 {
+	res, _ := i.apply(other, wrapping(mul[int16]))
+
+	return res
+}
+
+// Div returns the quotient of i and other truncated towards zero, or NULL if
+// either value is NULL. Dividing a non-NULL value by zero returns
+// ErrDivisionByZero rather than panicking. Dividing the smallest int16 by -1
+// wraps around, use CheckedDiv to detect it.
+func (i Int16) Div(other Int16) (Int16, error) {
+	return i.apply(other, wrapping(div[int16]))
+}
+
+// Mod returns the remainder of i divided by other, which has the sign of i,
+// or NULL if either value is NULL. Dividing a non-NULL value by zero returns
+// ErrDivisionByZero rather than panicking.
+func (i Int16) Mod(other Int16) (Int16, error) {
+	return i.apply(other, mod[int16])
+}
+
+// Neg returns the negation of i, or NULL if i is NULL. Negating the smallest
+// int16 wraps around to itself.
+func (i Int16) Neg() Int16 {
+	res, _ := i.apply(NewInt16(0), wrapping(neg[int16]))
+
+	return res
+}
+
+// Abs returns the absolute value of i, or NULL if i is NULL. The absolute
+// value of the smallest int16 wraps around to itself.
+func (i Int16) Abs() Int16 {
+	res, _ := i.apply(NewInt16(0), wrapping(abs[int16]))
+
+	return res
+}
+
+// CheckedAdd is like Add but returns ErrOverflow if the result overflows.
+func (i Int16) CheckedAdd(other Int16) (Int16, error) {
+	return i.apply(other, add[int16])
+}
+
+// CheckedSub is like Sub but returns ErrOverflow if the result overflows.
+func (i Int16) CheckedSub(other Int16) (Int16, error) {
+	return i.apply(other, sub[int16])
+}
+
+// CheckedMul is like Mul but returns ErrOverflow if the result overflows.
+func (i Int16) CheckedMul(other Int16) (Int16, error) {
+	return i.apply(other, mul[int16])
+}
+
+// CheckedDiv is like Div but returns ErrOverflow if the result overflows.
+func (i Int16) CheckedDiv(other Int16) (Int16, error) {
+	return i.apply(other, div[int16])
+}
+
+// CheckedNeg is like Neg but returns ErrOverflow if the result overflows.
+func (i Int16) CheckedNeg() (Int16, error) {
+	return i.apply(NewInt16(0), neg[int16])
+}
+
+// CheckedAbs is like Abs but returns ErrOverflow if the result overflows.
+func (i Int16) CheckedAbs() (Int16, error) {
+	return i.apply(NewInt16(0), abs[int16])
+}
+
+// signed is the set of integer types underlying the nullable integer types.
+type signed interface {
+	~int64 | ~int32 | ~int16
+}
+
+// apply calls op with the underlying values of i and other following SQL NULL
+// propagation, where the result is NULL if either operand is NULL. Unary
+// operations pass a non-NULL zero as other.
+func (i Int64) apply(other Int64, op func(a, b int64) (int64, error)) (Int64, error) {
+	if !i.Valid || !other.Valid {
+		return Int64{}, nil
+	}
+
+	res, err := op(i.Int64, other.Int64)
+	if err != nil {
+		return Int64{}, err
+	}
+
+	return NewInt64(res), nil
+}
+
+// apply calls op with the underlying values of i and other following SQL NULL
+// propagation, where the result is NULL if either operand is NULL. Unary
+// operations pass a non-NULL zero as other.
+func (i Int32) apply(other Int32, op func(a, b int32) (int32, error)) (Int32, error) {
+	if !i.Valid || !other.Valid {
+		return Int32{}, nil
+	}
+
+	res, err := op(i.Int32, other.Int32)
+	if err != nil {
+		return Int32{}, err
+	}
+
+	return NewInt32(res), nil
+}
+
+// apply calls op with the underlying values of i and other following SQL NULL
+// propagation, where the result is NULL if either operand is NULL. Unary
+// operations pass a non-NULL zero as other.
+func (i Int16) apply(other Int16, op func(a, b int16) (int16, error)) (Int16, error) {
+	if !i.Valid || !other.Valid {
+		return Int16{}, nil
+	}
+
+	res, err := op(i.Int16, other.Int16)
+	if err != nil {
+		return Int16{}, err
+	}
+
+	return NewInt16(res), nil
+}
+
+// wrapping turns a checked operation into one that ignores ErrOverflow and
+// returns the wrapped-around result, while still reporting other errors.
+func wrapping[T signed](op func(a, b T) (T, error)) func(a, b T) (T, error) {
+	return func(a, b T) (T, error) {
+		res, err := op(a, b)
+		if errors.Is(err, ErrOverflow) {
+			return res, nil
+		}
+
+		return res, err
+	}
+}
+
+// isMin reports whether v is the smallest value of its type, which is the
+// only non-zero value that is its own negation.
+func isMin[T signed](v T) bool {
+	return v < 0 && -v == v
+}
+
+func add[T signed](a, b T) (T, error) {
+	res := a + b
+	if (res > a) != (b > 0) {
+		return res, ErrOverflow
+	}
+
+	return res, nil
+}
+
+func sub[T signed](a, b T) (T, error) {
+	res := a - b
+	if (res < a) != (b > 0) {
+		return res, ErrOverflow
+	}
+
+	return res, nil
+}
+
+func mul[T signed](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+
+	res := a * b
+	if (a == -1 && isMin(b)) || (b == -1 && isMin(a)) || res/b != a {
+		return res, ErrOverflow
+	}
+
+	return res, nil
+}
+
+func div[T signed](a, b T) (T, error) {
+	if b == 0 {
+		return 0, ErrDivisionByZero
+	}
+	if b == -1 && isMin(a) {
+		return a, ErrOverflow
+	}
+
+	return a / b, nil
+}
+
+func mod[T signed](a, b T) (T, error) {
+	if b == 0 {
+		return 0, ErrDivisionByZero
+	}
+
+	return a % b, nil
+}
+
+func neg[T signed](a, _ T) (T, error) {
+	if isMin(a) {
+		return a, ErrOverflow
+	}
+
+	return -a, nil
+}
+
+func abs[T signed](a, _ T) (T, error) {
+	if a < 0 {
+		return neg(a, 0)
+	}
+
+	return a, nil
+}
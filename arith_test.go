@@ -0,0 +1,235 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestInt64Arithmetic(t *testing.T) {
+	null := Int64{}
+	stale := Int64{Int64: 7, Valid: false}
+
+	testCases := []struct {
+		label string
+		got   Int64
+		want  Int64
+	}{
+		{"add", NewInt64(2).Add(NewInt64(3)), NewInt64(5)},
+		{"add with NULL", NewInt64(2).Add(null), null},
+		{"add to NULL", stale.Add(NewInt64(3)), null},
+		{"add wraps around", NewInt64(math.MaxInt64).Add(NewInt64(1)), NewInt64(math.MinInt64)},
+		{"sub", NewInt64(2).Sub(NewInt64(3)), NewInt64(-1)},
+		{"sub with NULL", NewInt64(2).Sub(stale), null},
+		{"sub wraps around", NewInt64(math.MinInt64).Sub(NewInt64(1)), NewInt64(math.MaxInt64)},
+		{"mul", NewInt64(-2).Mul(NewInt64(3)), NewInt64(-6)},
+		{"mul with NULL", null.Mul(NewInt64(3)), null},
+		{"mul wraps around", NewInt64(math.MaxInt64).Mul(NewInt64(2)), NewInt64(-2)},
+		{"neg", NewInt64(2).Neg(), NewInt64(-2)},
+		{"neg NULL", stale.Neg(), null},
+		{"neg wraps around", NewInt64(math.MinInt64).Neg(), NewInt64(math.MinInt64)},
+		{"abs", NewInt64(-2).Abs(), NewInt64(2)},
+		{"abs of positive", NewInt64(2).Abs(), NewInt64(2)},
+		{"abs NULL", stale.Abs(), null},
+		{"abs wraps around", NewInt64(math.MinInt64).Abs(), NewInt64(math.MinInt64)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if tc.got != tc.want {
+				t.Errorf("got: %v, want: %v", tc.got, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestInt64Division(t *testing.T) {
+	null := Int64{}
+
+	testCases := []struct {
+		label   string
+		subject Int64
+		other   Int64
+		wantDiv Int64
+		wantMod Int64
+		wantErr error
+	}{
+		{"with positive operands", NewInt64(7), NewInt64(2), NewInt64(3), NewInt64(1), nil},
+		{"with negative dividend", NewInt64(-7), NewInt64(2), NewInt64(-3), NewInt64(-1), nil},
+		{"with negative divisor", NewInt64(7), NewInt64(-2), NewInt64(-3), NewInt64(1), nil},
+		{"with NULL dividend", null, NewInt64(2), null, null, nil},
+		{"with NULL divisor", NewInt64(7), null, null, null, nil},
+		{"with NULL dividend + zero divisor", null, NewInt64(0), null, null, nil},
+		{"with zero divisor", NewInt64(7), NewInt64(0), null, null, ErrDivisionByZero},
+		{"with minimum over -1", NewInt64(math.MinInt64), NewInt64(-1), NewInt64(math.MinInt64), NewInt64(0), nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := tc.subject.Div(tc.other)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if res != tc.wantDiv {
+				t.Errorf("got: %v, want: %v", res, tc.wantDiv)
+				return
+			}
+
+			res, err = tc.subject.Mod(tc.other)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if res != tc.wantMod {
+				t.Errorf("got: %v, want: %v", res, tc.wantMod)
+				return
+			}
+		})
+	}
+}
+
+func TestInt64CheckedArithmetic(t *testing.T) {
+	null := Int64{}
+
+	testCases := []struct {
+		label   string
+		op      func() (Int64, error)
+		want    Int64
+		wantErr error
+	}{
+		{"add", func() (Int64, error) { return NewInt64(2).CheckedAdd(NewInt64(3)) }, NewInt64(5), nil},
+		{"add with NULL", func() (Int64, error) { return null.CheckedAdd(NewInt64(math.MaxInt64)) }, null, nil},
+		{"add at maximum", func() (Int64, error) { return NewInt64(math.MaxInt64 - 1).CheckedAdd(NewInt64(1)) }, NewInt64(math.MaxInt64), nil},
+		{"add overflow", func() (Int64, error) { return NewInt64(math.MaxInt64).CheckedAdd(NewInt64(1)) }, null, ErrOverflow},
+		{"add underflow", func() (Int64, error) { return NewInt64(math.MinInt64).CheckedAdd(NewInt64(-1)) }, null, ErrOverflow},
+		{"sub", func() (Int64, error) { return NewInt64(2).CheckedSub(NewInt64(3)) }, NewInt64(-1), nil},
+		{"sub overflow", func() (Int64, error) { return NewInt64(math.MaxInt64).CheckedSub(NewInt64(-1)) }, null, ErrOverflow},
+		{"sub underflow", func() (Int64, error) { return NewInt64(math.MinInt64).CheckedSub(NewInt64(1)) }, null, ErrOverflow},
+		{"sub from zero", func() (Int64, error) { return NewInt64(0).CheckedSub(NewInt64(math.MinInt64)) }, null, ErrOverflow},
+		{"mul", func() (Int64, error) { return NewInt64(-2).CheckedMul(NewInt64(3)) }, NewInt64(-6), nil},
+		{"mul by zero", func() (Int64, error) { return NewInt64(math.MinInt64).CheckedMul(NewInt64(0)) }, NewInt64(0), nil},
+		{"mul at minimum", func() (Int64, error) { return NewInt64(math.MinInt64 / 2).CheckedMul(NewInt64(2)) }, NewInt64(math.MinInt64), nil},
+		{"mul overflow", func() (Int64, error) { return NewInt64(math.MaxInt64).CheckedMul(NewInt64(2)) }, null, ErrOverflow},
+		{"mul minimum by -1", func() (Int64, error) { return NewInt64(math.MinInt64).CheckedMul(NewInt64(-1)) }, null, ErrOverflow},
+		{"mul -1 by minimum", func() (Int64, error) { return NewInt64(-1).CheckedMul(NewInt64(math.MinInt64)) }, null, ErrOverflow},
+		{"div", func() (Int64, error) { return NewInt64(7).CheckedDiv(NewInt64(2)) }, NewInt64(3), nil},
+		{"div by zero", func() (Int64, error) { return NewInt64(7).CheckedDiv(NewInt64(0)) }, null, ErrDivisionByZero},
+		{"div minimum by -1", func() (Int64, error) { return NewInt64(math.MinInt64).CheckedDiv(NewInt64(-1)) }, null, ErrOverflow},
+		{"neg", func() (Int64, error) { return NewInt64(math.MaxInt64).CheckedNeg() }, NewInt64(-math.MaxInt64), nil},
+		{"neg NULL", func() (Int64, error) { return null.CheckedNeg() }, null, nil},
+		{"neg minimum", func() (Int64, error) { return NewInt64(math.MinInt64).CheckedNeg() }, null, ErrOverflow},
+		{"abs", func() (Int64, error) { return NewInt64(-math.MaxInt64).CheckedAbs() }, NewInt64(math.MaxInt64), nil},
+		{"abs minimum", func() (Int64, error) { return NewInt64(math.MinInt64).CheckedAbs() }, null, ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := tc.op()
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestInt32Arithmetic(t *testing.T) {
+	null := Int32{}
+
+	testCases := []struct {
+		label   string
+		op      func() (Int32, error)
+		want    Int32
+		wantErr error
+	}{
+		{"add", func() (Int32, error) { return NewInt32(2).Add(NewInt32(3)), nil }, NewInt32(5), nil},
+		{"add with NULL", func() (Int32, error) { return NewInt32(2).Add(null), nil }, null, nil},
+		{"add wraps around", func() (Int32, error) { return NewInt32(math.MaxInt32).Add(NewInt32(1)), nil }, NewInt32(math.MinInt32), nil},
+		{"checked add overflow", func() (Int32, error) { return NewInt32(math.MaxInt32).CheckedAdd(NewInt32(1)) }, null, ErrOverflow},
+		{"checked sub overflow", func() (Int32, error) { return NewInt32(math.MinInt32).CheckedSub(NewInt32(1)) }, null, ErrOverflow},
+		{"checked mul overflow", func() (Int32, error) { return NewInt32(1 << 16).CheckedMul(NewInt32(1 << 15)) }, null, ErrOverflow},
+		{"checked div overflow", func() (Int32, error) { return NewInt32(math.MinInt32).CheckedDiv(NewInt32(-1)) }, null, ErrOverflow},
+		{"checked abs overflow", func() (Int32, error) { return NewInt32(math.MinInt32).CheckedAbs() }, null, ErrOverflow},
+		{"div by zero", func() (Int32, error) { return NewInt32(1).Div(NewInt32(0)) }, null, ErrDivisionByZero},
+		{"mod by zero", func() (Int32, error) { return NewInt32(1).Mod(NewInt32(0)) }, null, ErrDivisionByZero},
+		{"mod", func() (Int32, error) { return NewInt32(-7).Mod(NewInt32(3)) }, NewInt32(-1), nil},
+		{"neg", func() (Int32, error) { return NewInt32(-7).Neg(), nil }, NewInt32(7), nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := tc.op()
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestInt16Arithmetic(t *testing.T) {
+	null := Int16{}
+
+	testCases := []struct {
+		label   string
+		op      func() (Int16, error)
+		want    Int16
+		wantErr error
+	}{
+		{"sub", func() (Int16, error) { return NewInt16(2).Sub(NewInt16(3)), nil }, NewInt16(-1), nil},
+		{"sub with NULL", func() (Int16, error) { return null.Sub(NewInt16(3)), nil }, null, nil},
+		{"mul wraps around", func() (Int16, error) { return NewInt16(math.MaxInt16).Mul(NewInt16(2)), nil }, NewInt16(-2), nil},
+		{"checked add overflow", func() (Int16, error) { return NewInt16(math.MaxInt16).CheckedAdd(NewInt16(1)) }, null, ErrOverflow},
+		{"checked sub overflow", func() (Int16, error) { return NewInt16(math.MinInt16).CheckedSub(NewInt16(1)) }, null, ErrOverflow},
+		{"checked mul overflow", func() (Int16, error) { return NewInt16(256).CheckedMul(NewInt16(128)) }, null, ErrOverflow},
+		{"checked mul at minimum", func() (Int16, error) { return NewInt16(-256).CheckedMul(NewInt16(128)) }, NewInt16(math.MinInt16), nil},
+		{"checked neg overflow", func() (Int16, error) { return NewInt16(math.MinInt16).CheckedNeg() }, null, ErrOverflow},
+		{"div by zero", func() (Int16, error) { return NewInt16(1).Div(NewInt16(0)) }, null, ErrDivisionByZero},
+		{"div wraps around", func() (Int16, error) { return NewInt16(math.MinInt16).Div(NewInt16(-1)) }, NewInt16(math.MinInt16), nil},
+		{"abs", func() (Int16, error) { return NewInt16(-7).Abs(), nil }, NewInt16(7), nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := tc.op()
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
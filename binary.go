@@ -21,9 +21,12 @@
 package nullable
 
 import (
+	"bytes"
+	"crypto/subtle"
 	"database/sql/driver"
 	"encoding/hex"
 	"fmt"
+	"io"
 )
 
 // Binary holds a nullable byte slice value.
@@ -70,6 +73,16 @@ func (b Binary) Present() bool {
 	return b.Valid && len(b.Bytes) > 0
 }
 
+// Presence returns the value itself if it is Present(), and NULL otherwise,
+// so that an empty byte slice can be stored as NULL in one call.
+func (b Binary) Presence() Binary {
+	if !b.Present() {
+		return Binary{}
+	}
+
+	return b
+}
+
 // Null returns true if the underlying value is NULL.
 func (b Binary) Null() bool {
 	return !b.Valid
@@ -88,3 +101,101 @@ func (b Binary) HexString() string {
 
 	return hex.EncodeToString(b.Bytes)
 }
+
+// WriteTo implements the io.WriterTo interface. Nothing is written if the
+// value is NULL.
+func (b Binary) WriteTo(w io.Writer) (int64, error) {
+	if !b.Valid {
+		return 0, nil
+	}
+	n, err := w.Write(b.Bytes)
+
+	return int64(n), err
+}
+
+// Reader returns an io.ReadSeeker over the underlying bytes without copying
+// them. The reader of a NULL value is empty.
+func (b Binary) Reader() io.ReadSeeker {
+	if !b.Valid {
+		return bytes.NewReader(nil)
+	}
+
+	return bytes.NewReader(b.Bytes)
+}
+
+// Len returns the number of bytes in the underlying value. NULL has a length
+// of zero, just like an empty byte slice.
+func (b Binary) Len() int {
+	if !b.Valid {
+		return 0
+	}
+
+	return len(b.Bytes)
+}
+
+// Equal reports whether b and other hold the same bytes. Two NULL values are
+// equal to each other, but NULL is never equal to an empty byte slice. Like
+// bytes.Equal, a nil slice and an empty slice are considered equal as long as
+// both values are non-NULL.
+func (b Binary) Equal(other Binary) bool {
+	if !b.Valid || !other.Valid {
+		return b.Valid == other.Valid
+	}
+
+	return bytes.Equal(b.Bytes, other.Bytes)
+}
+
+// Normalize drops the payload of a NULL value, so that stale bytes can not be
+// observed by code that ignores Valid.
+func (b *Binary) Normalize() {
+	if !b.Valid {
+		*b = Binary{}
+	}
+}
+
+// ConstantTimeEqual is like Equal but compares the bytes in constant time,
+// which makes it suitable for comparing secrets such as tokens. Unlike Equal,
+// a NULL value is never equal to anything, including another NULL value, so
+// that a missing token can not match a missing token.
+func (b Binary) ConstantTimeEqual(other Binary) bool {
+	if !b.Valid || !other.Valid {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(b.Bytes, other.Bytes) == 1
+}
+
+// Compare returns an integer comparing b and other lexicographically. The
+// result is 0 if b == other, -1 if b < other, and +1 if b > other. NULL sorts
+// after all non-NULL values, including the empty byte slice, which mirrors
+// the default ascending order of PostgreSQL.
+func (b Binary) Compare(other Binary) int {
+	return compareNullsLast(b.Valid, other.Valid, bytes.Compare(b.Bytes, other.Bytes))
+}
+
+// HasPrefix reports whether the underlying value begins with prefix. NULL has
+// no prefix, not even an empty one.
+func (b Binary) HasPrefix(prefix []byte) bool {
+	return b.Valid && bytes.HasPrefix(b.Bytes, prefix)
+}
+
+// Append appends data to the underlying value. Appending to NULL starts from
+// an empty byte slice, so the value is always non-NULL afterwards.
+func (b *Binary) Append(data ...byte) {
+	if !b.Valid {
+		b.Bytes = nil
+	}
+	b.Bytes = append(b.Bytes, data...)
+	b.Valid = true
+}
+
+// Slice returns a Binary holding b.Bytes[low:high]. The result shares the
+// underlying array with b. Slicing NULL returns NULL, and like the built-in
+// slice expression, Slice panics if the indexes are out of range.
+func (b Binary) Slice(low, high int) Binary {
+	if !b.Valid {
+		return Binary{}
+	}
+
+	return NewBinary(b.Bytes[low:high])
+}
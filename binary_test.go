@@ -21,6 +21,8 @@
 package nullable
 
 import (
+	"bytes"
+	"io"
 	"slices"
 	"testing"
 )
@@ -190,3 +192,294 @@ func TestBinaryHexString(t *testing.T) {
 		})
 	}
 }
+
+func TestBinaryLen(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Binary
+		want    int
+	}{
+		{"with NULL binary", Binary{Valid: false}, 0},
+		{"with NULL binary + non-empty bytes", Binary{Bytes: []byte("sneaky"), Valid: false}, 0},
+		{"with empty bytes", Binary{Bytes: []byte{}, Valid: true}, 0},
+		{"with non-empty bytes", Binary{Bytes: []byte("hello"), Valid: true}, 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.Len(); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBinaryEqual(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Binary
+		other   Binary
+		want    bool
+	}{
+		{"with both NULL", Binary{}, Binary{}, true},
+		{"with both NULL + stale bytes", Binary{Bytes: []byte("a")}, Binary{Bytes: []byte("b")}, true},
+		{"with NULL and empty bytes", Binary{}, NewBinary([]byte{}), false},
+		{"with empty and NULL bytes", NewBinary([]byte{}), Binary{}, false},
+		{"with nil and empty bytes", NewBinary(nil), NewBinary([]byte{}), true},
+		{"with same bytes", NewBinary([]byte("hello")), NewBinary([]byte("hello")), true},
+		{"with different bytes", NewBinary([]byte("hello")), NewBinary([]byte("world")), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.Equal(tc.other); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBinaryConstantTimeEqual(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Binary
+		other   Binary
+		want    bool
+	}{
+		{"with both NULL", Binary{}, Binary{}, false},
+		{"with NULL and empty bytes", Binary{}, NewBinary([]byte{}), false},
+		{"with both empty bytes", NewBinary([]byte{}), NewBinary(nil), true},
+		{"with same bytes", NewBinary([]byte("token")), NewBinary([]byte("token")), true},
+		{"with different bytes", NewBinary([]byte("token")), NewBinary([]byte("tokem")), false},
+		{"with different lengths", NewBinary([]byte("token")), NewBinary([]byte("tok")), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.ConstantTimeEqual(tc.other); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBinaryCompare(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Binary
+		other   Binary
+		want    int
+	}{
+		{"with both NULL", Binary{}, Binary{}, 0},
+		{"with NULL and empty bytes", Binary{}, NewBinary([]byte{}), 1},
+		{"with empty bytes and NULL", NewBinary([]byte{}), Binary{}, -1},
+		{"with same bytes", NewBinary([]byte("a")), NewBinary([]byte("a")), 0},
+		{"with lesser bytes", NewBinary([]byte("a")), NewBinary([]byte("b")), -1},
+		{"with greater bytes", NewBinary([]byte("b")), NewBinary([]byte("a")), 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.Compare(tc.other); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBinaryHasPrefix(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Binary
+		prefix  []byte
+		want    bool
+	}{
+		{"with NULL binary", Binary{}, []byte{}, false},
+		{"with NULL binary + stale bytes", Binary{Bytes: []byte("hello")}, []byte("he"), false},
+		{"with empty prefix", NewBinary([]byte("hello")), []byte{}, true},
+		{"with matching prefix", NewBinary([]byte("hello")), []byte("he"), true},
+		{"with non-matching prefix", NewBinary([]byte("hello")), []byte("lo"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.HasPrefix(tc.prefix); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBinaryAppend(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Binary
+		input   []byte
+		want    []byte
+	}{
+		{"with NULL binary", Binary{}, []byte("lo"), []byte("lo")},
+		{"with NULL binary + stale bytes", Binary{Bytes: []byte("hel")}, []byte("lo"), []byte("lo")},
+		{"with NULL binary + no data", Binary{}, nil, []byte{}},
+		{"with non-empty bytes", NewBinary([]byte("hel")), []byte("lo"), []byte("hello")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			tc.subject.Append(tc.input...)
+
+			if !tc.subject.Valid {
+				t.Errorf("got: %v, want: %v", tc.subject.Valid, true)
+				return
+			}
+			if !slices.Equal(tc.subject.Bytes, tc.want) {
+				t.Errorf("got: %v, want: %v", tc.subject.Bytes, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBinarySlice(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Binary
+		low     int
+		high    int
+		want    Binary
+	}{
+		{"with NULL binary", Binary{Bytes: []byte("hello")}, 0, 2, Binary{}},
+		{"with full range", NewBinary([]byte("hello")), 0, 5, NewBinary([]byte("hello"))},
+		{"with partial range", NewBinary([]byte("hello")), 1, 3, NewBinary([]byte("el"))},
+		{"with empty range", NewBinary([]byte("hello")), 2, 2, NewBinary([]byte{})},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.Slice(tc.low, tc.high); !res.Equal(tc.want) {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBinaryWriteTo(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Binary
+		want    string
+	}{
+		{"with NULL binary", Binary{Valid: false}, ""},
+		{"with NULL binary + non-empty bytes", Binary{Bytes: []byte("sneaky"), Valid: false}, ""},
+		{"with empty bytes", Binary{Bytes: []byte{}, Valid: true}, ""},
+		{"with non-empty bytes", Binary{Bytes: []byte("hello"), Valid: true}, "hello"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			n, err := tc.subject.WriteTo(&buf)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if n != int64(len(tc.want)) {
+				t.Errorf("got: %v, want: %v", n, len(tc.want))
+				return
+			}
+			if buf.String() != tc.want {
+				t.Errorf("got: %v, want: %v", buf.String(), tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBinaryReader(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Binary
+		want    string
+	}{
+		{"with NULL binary", Binary{Valid: false}, ""},
+		{"with NULL binary + non-empty bytes", Binary{Bytes: []byte("sneaky"), Valid: false}, ""},
+		{"with non-empty bytes", Binary{Bytes: []byte("hello"), Valid: true}, "hello"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			r := tc.subject.Reader()
+
+			res, err := io.ReadAll(r)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if string(res) != tc.want {
+				t.Errorf("got: %v, want: %v", string(res), tc.want)
+				return
+			}
+
+			if _, err := r.Seek(0, io.SeekStart); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res, _ := io.ReadAll(r); string(res) != tc.want {
+				t.Errorf("got: %v, want: %v", string(res), tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBinaryPresence(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Binary
+		want    Binary
+	}{
+		{"with NULL binary", Binary{Bytes: []byte("stale")}, Binary{}},
+		{"with empty bytes", NewBinary([]byte{}), Binary{}},
+		{"with non-empty bytes", NewBinary([]byte("hello")), NewBinary([]byte("hello"))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res := tc.subject.Presence()
+
+			if res.Valid != tc.want.Valid || string(res.Bytes) != string(tc.want.Bytes) {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBinaryNormalize(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Binary
+		want    []byte
+	}{
+		{"with NULL binary", Binary{Bytes: []byte("stale")}, nil},
+		{"with non-empty bytes", NewBinary([]byte("hello")), []byte("hello")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			valid := tc.subject.Valid
+			tc.subject.Normalize()
+
+			if tc.subject.Valid != valid || !slices.Equal(tc.subject.Bytes, tc.want) {
+				t.Errorf("got: %v, want: %v", tc.subject.Bytes, tc.want)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync/atomic"
+)
+
+// ErrBlobTooLarge is returned when a Blob yields more bytes than its limit.
+var ErrBlobTooLarge = errors.New("nullable: blob exceeds size limit")
+
+// ErrBlobConsumed is returned when the payload of a Blob is requested again
+// after its reader has been read, such as when database/sql converts the
+// arguments of a retried query.
+var ErrBlobConsumed = errors.New("nullable: blob already consumed")
+
+// Blob holds a nullable binary large object backed by an io.Reader. Unlike
+// Binary, the payload is not read until Value is called, which lets an upload
+// be streamed straight into a query argument instead of being buffered by
+// the caller first. A Blob is meant for the write path and can only be
+// consumed once, by any of its copies.
+type Blob struct {
+	src   *blobSource
+	Valid bool
+}
+
+// blobSource is the reader of a Blob, shared by its copies so that they
+// agree on whether it has been consumed.
+type blobSource struct {
+	r        io.Reader
+	limit    int64
+	consumed atomic.Bool
+}
+
+// NewBlob returns a Blob that reads its payload from r, failing with
+// ErrBlobTooLarge if r yields more than limit bytes. A limit of zero or less
+// disables the size cap. A nil reader is treated as NULL.
+func NewBlob(r io.Reader, limit int64) Blob {
+	if r == nil {
+		return Blob{}
+	}
+
+	return Blob{src: &blobSource{r: r, limit: limit}, Valid: true}
+}
+
+// Value implements the driver.Valuer interface. The underlying reader is
+// drained into a single buffer, which is sized up front when the reader
+// reports its length. Since the reader can not be rewound, any later call
+// returns ErrBlobConsumed rather than an empty or partial payload.
+func (b Blob) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	if b.src == nil {
+		return []byte{}, nil
+	}
+	if b.src.consumed.Swap(true) {
+		return nil, ErrBlobConsumed
+	}
+
+	buf, err := b.src.read()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+func (s *blobSource) read() ([]byte, error) {
+	if l, ok := s.r.(interface{ Len() int }); ok {
+		size := int64(l.Len())
+		if s.limit > 0 && size > s.limit {
+			return nil, ErrBlobTooLarge
+		}
+
+		buf := make([]byte, size)
+		if _, err := io.ReadFull(s.r, buf); err != nil {
+			return nil, err
+		}
+
+		return buf, nil
+	}
+
+	if s.limit <= 0 {
+		return io.ReadAll(s.r)
+	}
+
+	// Read one byte past the limit so that an oversized payload can be told
+	// apart from one that is exactly at the limit.
+	buf, err := io.ReadAll(io.LimitReader(s.r, s.limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(buf)) > s.limit {
+		return nil, ErrBlobTooLarge
+	}
+
+	return buf, nil
+}
+
+// Null returns true if the underlying value is NULL.
+func (b Blob) Null() bool {
+	return !b.Valid
+}
+
+// Nil is an alias for Null() for those who prefer a more Go-like syntax.
+func (b Blob) Nil() bool {
+	return b.Null()
+}
+
+// Normalize drops the reader of a NULL value.
+func (b *Blob) Normalize() {
+	if !b.Valid {
+		*b = Blob{}
+	}
+}
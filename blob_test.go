@@ -0,0 +1,128 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// onlyReader hides any optional methods of the wrapped reader, such as Len().
+type onlyReader struct {
+	io.Reader
+}
+
+func TestNewBlob(t *testing.T) {
+	testCases := []struct {
+		label string
+		input io.Reader
+		want  bool
+	}{
+		{"with nil reader", nil, false},
+		{"with reader", strings.NewReader("x"), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			val := NewBlob(tc.input, 0)
+
+			if val.Valid != tc.want {
+				t.Errorf("got: %v, want: %v", val.Valid, tc.want)
+				return
+			}
+			if val.Null() == tc.want || val.Nil() == tc.want {
+				t.Errorf("got: %v, want: %v", val.Null(), !tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBlobValue(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Blob
+		want    any
+		wantErr error
+	}{
+		{"with NULL blob", Blob{}, nil, nil},
+		{"with sized reader", NewBlob(strings.NewReader("hello"), 0), []byte("hello"), nil},
+		{"with sized reader at limit", NewBlob(strings.NewReader("hello"), 5), []byte("hello"), nil},
+		{"with sized reader over limit", NewBlob(strings.NewReader("hello"), 4), nil, ErrBlobTooLarge},
+		{"with plain reader", NewBlob(onlyReader{strings.NewReader("hello")}, 0), []byte("hello"), nil},
+		{"with plain reader at limit", NewBlob(onlyReader{strings.NewReader("hello")}, 5), []byte("hello"), nil},
+		{"with plain reader over limit", NewBlob(onlyReader{strings.NewReader("hello")}, 4), nil, ErrBlobTooLarge},
+		{"with empty reader", NewBlob(strings.NewReader(""), 1), []byte{}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := tc.subject.Value()
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if tc.want == nil {
+				if res != nil {
+					t.Errorf("got: %v, want: %v", res, nil)
+				}
+				return
+			}
+			if b, ok := res.([]byte); !ok || !slices.Equal(b, tc.want.([]byte)) {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestBlobValueConsumed(t *testing.T) {
+	testCases := []struct {
+		label string
+		input io.Reader
+	}{
+		{"with sized reader", strings.NewReader("hello")},
+		{"with plain reader", onlyReader{strings.NewReader("hello")}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			subject := NewBlob(tc.input, 0)
+			retry := subject
+
+			if _, err := subject.Value(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res, err := subject.Value(); !errors.Is(err, ErrBlobConsumed) || res != nil {
+				t.Errorf("got: %v, %v, want: %v", res, err, ErrBlobConsumed)
+				return
+			}
+			if _, err := retry.Value(); !errors.Is(err, ErrBlobConsumed) {
+				t.Errorf("got: %v, want: %v", err, ErrBlobConsumed)
+				return
+			}
+		})
+	}
+}
@@ -59,3 +59,22 @@ func (b Byte) Null() bool {
 func (b Byte) Nil() bool {
 	return b.Null()
 }
+
+// Equal reports whether b and other hold the same value. All NULL values are
+// equal to each other regardless of any stale payload, and NULL is never
+// equal to a non-NULL value.
+func (b Byte) Equal(other Byte) bool {
+	if !b.Valid || !other.Valid {
+		return b.Valid == other.Valid
+	}
+
+	return b.Byte == other.Byte
+}
+
+// Normalize zeroes the payload of a NULL value, so that it compares equal to
+// any other NULL value with ==.
+func (b *Byte) Normalize() {
+	if !b.Valid {
+		*b = Byte{}
+	}
+}
@@ -71,3 +71,49 @@ func TestByteNull(t *testing.T) {
 		})
 	}
 }
+
+func TestByteEqual(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Byte
+		other   Byte
+		want    bool
+	}{
+		{"with both NULL", Byte{}, Byte{}, true},
+		{"with both NULL + stale bytes", Byte{Byte: 1}, Byte{Byte: 2}, true},
+		{"with NULL and zero", Byte{}, NewByte(0), false},
+		{"with same bytes", NewByte(1), NewByte(1), true},
+		{"with different bytes", NewByte(1), NewByte(2), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.Equal(tc.other); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestByteNormalize(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Byte
+		want    Byte
+	}{
+		{"with NULL byte", Byte{Byte: 1}, Byte{}},
+		{"with non-NULL byte", NewByte(1), NewByte(1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			tc.subject.Normalize()
+
+			if tc.subject != tc.want {
+				t.Errorf("got: %v, want: %v", tc.subject, tc.want)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+// Coalesce returns the first non-NULL value like the SQL COALESCE() function,
+// or NULL if all of the values are NULL.
+func Coalesce[T Nullable](values ...T) T {
+	for _, v := range values {
+		if !v.Null() {
+			return v
+		}
+	}
+
+	var null T
+	return null
+}
+
+// NullIf returns NULL if value is equal to sentinel and value otherwise, like
+// the SQL NULLIF() function. For example, NullIf(v, NewInt64(-1)) turns a -1
+// placeholder into NULL. A NULL sentinel never matches a non-NULL value.
+func NullIf[T Ordered[T]](value, sentinel T) T {
+	if !value.Null() && value.Compare(sentinel) == 0 {
+		var null T
+		return null
+	}
+
+	return value
+}
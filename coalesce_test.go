@@ -0,0 +1,93 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCoalesce(t *testing.T) {
+	testCases := []struct {
+		label string
+		input []String
+		want  String
+	}{
+		{"with no values", nil, String{}},
+		{"with only NULL values", []String{{}, {String: "stale"}}, String{}},
+		{"with leading NULL", []String{{String: "stale"}, NewString(""), NewString("a")}, NewString("")},
+		{"with leading value", []String{NewString("a"), NewString("b")}, NewString("a")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := Coalesce(tc.input...); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestCoalesceBinary(t *testing.T) {
+	res := Coalesce(Binary{Bytes: []byte("stale")}, NewBinary([]byte("hello")))
+
+	if !res.Equal(NewBinary([]byte("hello"))) {
+		t.Errorf("got: %v, want: %v", res, "hello")
+		return
+	}
+}
+
+func TestNullIf(t *testing.T) {
+	testCases := []struct {
+		label    string
+		value    Int64
+		sentinel Int64
+		want     Int64
+	}{
+		{"with matching sentinel", NewInt64(-1), NewInt64(-1), Int64{}},
+		{"with other sentinel", NewInt64(1), NewInt64(-1), NewInt64(1)},
+		{"with NULL sentinel", NewInt64(0), Int64{}, NewInt64(0)},
+		{"with NULL value", Int64{Int64: -1}, NewInt64(-1), Int64{Int64: -1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := NullIf(tc.value, tc.sentinel); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestNullIfTime(t *testing.T) {
+	var epoch time.Time
+
+	if res := NullIf(NewTime(epoch), NewTime(epoch)); !res.Null() {
+		t.Errorf("got: %v, want: NULL", res)
+		return
+	}
+	if res := NullIf(NewTime(epoch.Add(1)), NewTime(epoch)); res.Null() {
+		t.Errorf("got: %v, want: %v", res, epoch.Add(1))
+		return
+	}
+}
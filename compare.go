@@ -0,0 +1,143 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"cmp"
+	"strings"
+)
+
+// Nullable is implemented by every type in this package.
+type Nullable interface {
+	Null() bool
+}
+
+// Ordered is implemented by the types in this package that have a Compare
+// method, which orders NULL after all non-NULL values.
+type Ordered[T any] interface {
+	Nullable
+	Compare(other T) int
+}
+
+// Asc compares a and b in ascending order with NULLs last, which is the
+// default of ORDER BY ... ASC in PostgreSQL. It can be passed directly to
+// slices.SortFunc and friends.
+func Asc[T Ordered[T]](a, b T) int {
+	return a.Compare(b)
+}
+
+// Desc compares a and b in descending order with NULLs first, which is the
+// default of ORDER BY ... DESC in PostgreSQL.
+func Desc[T Ordered[T]](a, b T) int {
+	return b.Compare(a)
+}
+
+// NullsFirst returns a comparison function that orders NULL before all
+// non-NULL values and defers to compare otherwise, like NULLS FIRST in SQL.
+// For example, NullsFirst(Asc[String]) sorts in ascending order with NULLs
+// first.
+func NullsFirst[T Nullable](compare func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		switch an, bn := a.Null(), b.Null(); {
+		case an && bn:
+			return 0
+		case an:
+			return -1
+		case bn:
+			return 1
+		}
+
+		return compare(a, b)
+	}
+}
+
+// NullsLast returns a comparison function that orders NULL after all non-NULL
+// values and defers to compare otherwise, like NULLS LAST in SQL.
+func NullsLast[T Nullable](compare func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		switch an, bn := a.Null(), b.Null(); {
+		case an && bn:
+			return 0
+		case an:
+			return 1
+		case bn:
+			return -1
+		}
+
+		return compare(a, b)
+	}
+}
+
+// compareNullsLast orders NULL after all non-NULL values and returns c, the
+// result of comparing the underlying values, if neither is NULL.
+func compareNullsLast(aValid, bValid bool, c int) int {
+	switch {
+	case !aValid && !bValid:
+		return 0
+	case !aValid:
+		return 1
+	case !bValid:
+		return -1
+	}
+
+	return c
+}
+
+// Compare returns -1, 0 or +1 depending on whether s sorts before, with or
+// after other. NULL sorts after all non-NULL values.
+func (s String) Compare(other String) int {
+	return compareNullsLast(s.Valid, other.Valid, strings.Compare(s.String, other.String))
+}
+
+// Compare returns -1, 0 or +1 depending on whether i sorts before, with or
+// after other. NULL sorts after all non-NULL values.
+func (i Int64) Compare(other Int64) int {
+	return compareNullsLast(i.Valid, other.Valid, cmp.Compare(i.Int64, other.Int64))
+}
+
+// Compare returns -1, 0 or +1 depending on whether i sorts before, with or
+// after other. NULL sorts after all non-NULL values.
+func (i Int32) Compare(other Int32) int {
+	return compareNullsLast(i.Valid, other.Valid, cmp.Compare(i.Int32, other.Int32))
+}
+
+// Compare returns -1, 0 or +1 depending on whether i sorts before, with or
+// after other. NULL sorts after all non-NULL values.
+func (i Int16) Compare(other Int16) int {
+	return compareNullsLast(i.Valid, other.Valid, cmp.Compare(i.Int16, other.Int16))
+}
+
+// Compare returns -1, 0 or +1 depending on whether b sorts before, with or
+// after other. NULL sorts after all non-NULL values.
+func (b Byte) Compare(other Byte) int {
+	return compareNullsLast(b.Valid, other.Valid, cmp.Compare(b.Byte, other.Byte))
+}
+
+// Compare returns -1, 0 or +1 depending on whether t sorts before, with or
+// after other. NULL sorts after all non-NULL values.
+func (t Time) Compare(other Time) int {
+	return compareNullsLast(t.Valid, other.Valid, t.Time.Compare(other.Time))
+}
+
+// Compare orders q and other like Int64.Compare.
+func (q QuotedInt64) Compare(other QuotedInt64) int {
+	return Int64(q).Compare(Int64(other))
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCompareSort(t *testing.T) {
+	null := String{String: "stale"}
+	input := []String{NewString("b"), null, NewString(""), NewString("a"), null}
+
+	testCases := []struct {
+		label string
+		cmp   func(a, b String) int
+		want  []String
+	}{
+		{"with Asc", Asc[String], []String{NewString(""), NewString("a"), NewString("b"), null, null}},
+		{"with Desc", Desc[String], []String{null, null, NewString("b"), NewString("a"), NewString("")}},
+		{"with Asc NULLS FIRST", NullsFirst(Asc[String]), []String{null, null, NewString(""), NewString("a"), NewString("b")}},
+		{"with Desc NULLS LAST", NullsLast(Desc[String]), []String{NewString("b"), NewString("a"), NewString(""), null, null}},
+		{"with method expression", String.Compare, []String{NewString(""), NewString("a"), NewString("b"), null, null}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res := slices.Clone(input)
+			slices.SortStableFunc(res, tc.cmp)
+
+			if !slices.Equal(res, tc.want) {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		label string
+		got   [3]int
+	}{
+		{"with String", [3]int{NewString("a").Compare(NewString("b")), String{}.Compare(String{String: "x"}), NewString("").Compare(String{})}},
+		{"with Int64", [3]int{NewInt64(-1).Compare(NewInt64(1)), Int64{}.Compare(Int64{Int64: 1}), NewInt64(0).Compare(Int64{})}},
+		{"with Int32", [3]int{NewInt32(-1).Compare(NewInt32(1)), Int32{}.Compare(Int32{Int32: 1}), NewInt32(0).Compare(Int32{})}},
+		{"with Int16", [3]int{NewInt16(-1).Compare(NewInt16(1)), Int16{}.Compare(Int16{Int16: 1}), NewInt16(0).Compare(Int16{})}},
+		{"with Byte", [3]int{NewByte(0).Compare(NewByte(1)), Byte{}.Compare(Byte{Byte: 1}), NewByte(0).Compare(Byte{})}},
+		{"with Time", [3]int{NewTime(now).Compare(NewTime(now.Add(1))), Time{}.Compare(Time{Time: now}), NewTime(time.Time{}).Compare(Time{})}},
+		{"with Binary", [3]int{NewBinary([]byte("a")).Compare(NewBinary([]byte("b"))), Binary{}.Compare(Binary{Bytes: []byte("x")}), NewBinary(nil).Compare(Binary{})}},
+	}
+
+	// Each case compares a lesser value with a greater one, two NULLs with
+	// different payloads, and a non-NULL zero value with NULL.
+	want := [3]int{-1, 0, -1}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if tc.got != want {
+				t.Errorf("got: %v, want: %v", tc.got, want)
+				return
+			}
+		})
+	}
+}
+
+func TestCompareSortInt64(t *testing.T) {
+	null := Int64{}
+	input := []Int64{NewInt64(3), null, NewInt64(-1), null, NewInt64(0)}
+
+	testCases := []struct {
+		label string
+		cmp   func(a, b Int64) int
+		want  []Int64
+	}{
+		{"with Asc", Asc[Int64], []Int64{NewInt64(-1), NewInt64(0), NewInt64(3), null, null}},
+		{"with Desc", Desc[Int64], []Int64{null, null, NewInt64(3), NewInt64(0), NewInt64(-1)}},
+		{"with Asc NULLS FIRST", NullsFirst(Asc[Int64]), []Int64{null, null, NewInt64(-1), NewInt64(0), NewInt64(3)}},
+		{"with Asc NULLS LAST", NullsLast(Asc[Int64]), []Int64{NewInt64(-1), NewInt64(0), NewInt64(3), null, null}},
+		{"with Desc NULLS FIRST", NullsFirst(Desc[Int64]), []Int64{null, null, NewInt64(3), NewInt64(0), NewInt64(-1)}},
+		{"with Desc NULLS LAST", NullsLast(Desc[Int64]), []Int64{NewInt64(3), NewInt64(0), NewInt64(-1), null, null}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res := slices.Clone(input)
+			slices.SortFunc(res, tc.cmp)
+
+			if !slices.Equal(res, tc.want) {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"database/sql"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// dbField is a struct field that maps to a database column.
+type dbField struct {
+	column string
+	index  []int
+}
+
+// dbFieldCache maps a struct type to its []dbField.
+var dbFieldCache sync.Map
+
+var scannerType = reflect.TypeFor[sql.Scanner]()
+
+// dbFields returns the fields of the struct type t that map to columns, in
+// declaration order. The column of a field is the name given by its db struct
+// tag, or its lowercased name if it has none, and fields tagged with db:"-"
+// are skipped. The fields of embedded structs are promoted as if they were
+// declared by t, but a field declared by t wins over a promoted field of the
+// same column.
+func dbFields(t reflect.Type) []dbField {
+	if fields, ok := dbFieldCache.Load(t); ok {
+		return fields.([]dbField)
+	}
+
+	var fields []dbField
+	seen := make(map[string]bool)
+
+	for _, f := range walkDBFields(t, nil, map[reflect.Type]bool{t: true}) {
+		if !seen[f.column] {
+			seen[f.column] = true
+			fields = append(fields, f)
+		}
+	}
+	slices.SortFunc(fields, func(a, b dbField) int {
+		return slices.Compare(a.index, b.index)
+	})
+
+	actual, _ := dbFieldCache.LoadOrStore(t, fields)
+	return actual.([]dbField)
+}
+
+// walkDBFields returns the fields declared by t before the fields promoted
+// from its embedded structs, so that the former take precedence. Embedded
+// structs that are already being walked are skipped, so that a struct
+// embedding a pointer to itself does not recurse forever.
+func walkDBFields(t reflect.Type, index []int, walking map[reflect.Type]bool) []dbField {
+	var own, promoted []dbField
+
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("db")
+		if tag == "-" {
+			continue
+		}
+		idx := append(slices.Clip(index), i)
+
+		if sf.Anonymous && tag == "" {
+			et := sf.Type
+			if et.Kind() == reflect.Pointer {
+				et = et.Elem()
+			}
+			if et.Kind() == reflect.Struct && !reflect.PointerTo(et).Implements(scannerType) {
+				if !walking[et] {
+					walking[et] = true
+					promoted = append(promoted, walkDBFields(et, idx, walking)...)
+					delete(walking, et)
+				}
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+
+		column := tag
+		if column == "" {
+			column = strings.ToLower(sf.Name)
+		}
+		own = append(own, dbField{column: column, index: idx})
+	}
+
+	return append(own, promoted...)
+}
+
+// structValue dereferences v until it reaches a struct, reporting false if
+// it runs into a nil pointer or a non-struct value.
+func structValue(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+
+	return v, v.Kind() == reflect.Struct
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+)
+
+// Change describes a column whose value differs between two versions of a
+// struct.
+type Change struct {
+	Column string
+	Old    any
+	New    any
+}
+
+// Diff compares the struct values old and cur field by field and returns a
+// Change for every column that differs, in field order. Columns are named by
+// db struct tags in the same way as UpdateColumns. Fields are compared with
+// their Equal method when they have one, so that NULL values are equal to
+// each other regardless of any stale payload, and with reflect.DeepEqual
+// otherwise. Diff returns an error if T is not a struct type.
+func Diff[T any](old, cur T) ([]Change, error) {
+	ov, ok := structValue(reflect.ValueOf(&old))
+	nv, nok := structValue(reflect.ValueOf(&cur))
+	if !ok || !nok {
+		return nil, fmt.Errorf("nullable: Diff of non-struct %T", old)
+	}
+
+	var changes []Change
+
+	for _, f := range dbFields(ov.Type()) {
+		of, oerr := ov.FieldByIndexErr(f.index)
+		nf, nerr := nv.FieldByIndexErr(f.index)
+		if oerr != nil || nerr != nil {
+			if oerr == nil || nerr == nil {
+				changes = append(changes, Change{Column: f.column, Old: fieldInterface(of, oerr), New: fieldInterface(nf, nerr)})
+			}
+			continue
+		}
+		if !equalValues(of, nf) {
+			changes = append(changes, Change{Column: f.column, Old: of.Interface(), New: nf.Interface()})
+		}
+	}
+
+	return changes, nil
+}
+
+// fieldInterface returns the value of a field, or nil if it could not be
+// reached through an embedded nil pointer.
+func fieldInterface(v reflect.Value, err error) any {
+	if err != nil {
+		return nil
+	}
+
+	return v.Interface()
+}
+
+// equalValues reports whether a and b, which are of the same type, are equal
+// according to their Equal method, or reflect.DeepEqual if they have none.
+func equalValues(a, b reflect.Value) bool {
+	if m := a.MethodByName("Equal"); m.IsValid() {
+		mt := m.Type()
+		if mt.NumIn() == 1 && mt.In(0) == a.Type() && mt.NumOut() == 1 && mt.Out(0).Kind() == reflect.Bool {
+			return m.Call([]reflect.Value{b})[0].Bool()
+		}
+	}
+
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// Snapshot records the state of a struct so that the columns changed since
+// can be listed later, which lets a repository write only what changed.
+type Snapshot[T any] struct {
+	ptr  *T
+	orig T
+}
+
+// Track returns a Snapshot of the struct pointed to by v. The bytes of
+// Binary fields are copied, so that changes made to them in place are
+// detected. Fields promoted through an embedded pointer are shared with v
+// rather than copied, so changes to them are not detected.
+func Track[T any](v *T) *Snapshot[T] {
+	s := &Snapshot[T]{ptr: v}
+	s.Reset()
+
+	return s
+}
+
+// Changes returns the columns that changed since the snapshot was taken.
+func (s *Snapshot[T]) Changes() ([]Change, error) {
+	return Diff(s.orig, *s.ptr)
+}
+
+// Changed returns true if any column changed since the snapshot was taken.
+func (s *Snapshot[T]) Changed() bool {
+	changes, err := s.Changes()
+	return err == nil && len(changes) > 0
+}
+
+// Reset retakes the snapshot, typically after the changes have been saved.
+func (s *Snapshot[T]) Reset() {
+	s.orig = *s.ptr
+
+	ov, ok := structValue(reflect.ValueOf(&s.orig))
+	if !ok {
+		return
+	}
+	for _, f := range dbFields(ov.Type()) {
+		if viaPointer(ov.Type(), f.index) {
+			continue
+		}
+		fv := ov.FieldByIndex(f.index)
+		if fv.Type() != reflect.TypeFor[Binary]() || !fv.CanSet() {
+			continue
+		}
+		b := fv.Interface().(Binary)
+		b.Bytes = bytes.Clone(b.Bytes)
+		fv.Set(reflect.ValueOf(b))
+	}
+}
+
+// viaPointer reports whether the field of t at index is reached through an
+// embedded pointer.
+func viaPointer(t reflect.Type, index []int) bool {
+	for _, i := range index[:len(index)-1] {
+		t = t.Field(i).Type
+		if t.Kind() == reflect.Pointer {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type diffRecord struct {
+	ID      int64  `db:"id"`
+	Name    String `db:"name"`
+	Age     Int32
+	Avatar  Binary      `db:"avatar"`
+	Created Time        `db:"created_at"`
+	Balance QuotedInt64 `db:"balance"`
+	Scratch String      `db:"-"`
+}
+
+func TestDiff(t *testing.T) {
+	now := time.Now()
+	base := diffRecord{
+		ID:      1,
+		Name:    NewString("Jane"),
+		Avatar:  NewBinary([]byte{1, 2}),
+		Created: NewTime(now),
+	}
+
+	testCases := []struct {
+		label string
+		edit  func(r *diffRecord)
+		want  []Change
+	}{
+		{"with no change", func(r *diffRecord) {}, nil},
+		{"with ignored field", func(r *diffRecord) { r.Scratch = NewString("x") }, nil},
+		{"with stale NULL payload", func(r *diffRecord) { r.Age = Int32{Int32: 7} }, nil},
+		{"with stale quoted NULL payload", func(r *diffRecord) { r.Balance = QuotedInt64{Int64: 7} }, nil},
+		{"with same instant in another zone", func(r *diffRecord) { r.Created = NewTime(now.UTC()) }, nil},
+		{
+			"with value to NULL",
+			func(r *diffRecord) { r.Name = String{} },
+			[]Change{{Column: "name", Old: NewString("Jane"), New: String{}}},
+		},
+		{
+			"with NULL to value",
+			func(r *diffRecord) { r.Age = NewInt32(30) },
+			[]Change{{Column: "age", Old: Int32{}, New: NewInt32(30)}},
+		},
+		{
+			"with several changes",
+			func(r *diffRecord) {
+				r.ID = 2
+				r.Avatar = NewBinary([]byte{})
+			},
+			[]Change{
+				{Column: "id", Old: int64(1), New: int64(2)},
+				{Column: "avatar", Old: NewBinary([]byte{1, 2}), New: NewBinary([]byte{})},
+			},
+		},
+		{
+			"with quoted change",
+			func(r *diffRecord) { r.Balance = NewQuotedInt64(5) },
+			[]Change{{Column: "balance", Old: QuotedInt64{}, New: NewQuotedInt64(5)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			edited := base
+			tc.edit(&edited)
+
+			res, err := Diff(base, edited)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if !reflect.DeepEqual(res, tc.want) {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+
+	if _, err := Diff(1, 2); err == nil {
+		t.Errorf("expected an error for a non-struct")
+		return
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	type embedded struct {
+		diffRecord
+		Version int `db:"version"`
+	}
+
+	record := embedded{diffRecord: diffRecord{Name: NewString("Jane"), Avatar: NewBinary([]byte("a"))}}
+	snapshot := Track(&record)
+
+	if snapshot.Changed() {
+		t.Errorf("got: %v, want: %v", true, false)
+		return
+	}
+
+	record.Avatar.Bytes[0] = 'b'
+	record.Version++
+
+	res, err := snapshot.Changes()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	var columns []string
+	for _, c := range res {
+		columns = append(columns, c.Column)
+	}
+	if want := []string{"avatar", "version"}; !reflect.DeepEqual(columns, want) {
+		t.Errorf("got: %v, want: %v", columns, want)
+		return
+	}
+
+	snapshot.Reset()
+	if snapshot.Changed() {
+		t.Errorf("got: %v, want: %v", true, false)
+		return
+	}
+}
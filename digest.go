@@ -0,0 +1,92 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"hash"
+)
+
+// SHA256 returns the SHA-256 digest of the underlying value, or NULL if the
+// value is NULL.
+func (s String) SHA256() Binary {
+	return digest(sha256.New(), s.Valid, []byte(s.String))
+}
+
+// SHA1 returns the SHA-1 digest of the underlying value, or NULL if the value
+// is NULL. SHA-1 is cryptographically broken and should only be used where
+// compatibility demands it.
+func (s String) SHA1() Binary {
+	return digest(sha1.New(), s.Valid, []byte(s.String))
+}
+
+// MD5 returns the MD5 digest of the underlying value, or NULL if the value is
+// NULL. MD5 is cryptographically broken and should only be used where
+// compatibility demands it, such as ETag values.
+func (s String) MD5() Binary {
+	return digest(md5.New(), s.Valid, []byte(s.String))
+}
+
+// HMAC returns the HMAC-SHA256 of the underlying value using the given key, or
+// NULL if the value is NULL.
+func (s String) HMAC(key []byte) Binary {
+	return digest(hmac.New(sha256.New, key), s.Valid, []byte(s.String))
+}
+
+// SHA256 returns the SHA-256 digest of the underlying value, or NULL if the
+// value is NULL.
+func (b Binary) SHA256() Binary {
+	return digest(sha256.New(), b.Valid, b.Bytes)
+}
+
+// SHA1 returns the SHA-1 digest of the underlying value, or NULL if the value
+// is NULL. SHA-1 is cryptographically broken and should only be used where
+// compatibility demands it.
+func (b Binary) SHA1() Binary {
+	return digest(sha1.New(), b.Valid, b.Bytes)
+}
+
+// MD5 returns the MD5 digest of the underlying value, or NULL if the value is
+// NULL. MD5 is cryptographically broken and should only be used where
+// compatibility demands it, such as ETag values.
+func (b Binary) MD5() Binary {
+	return digest(md5.New(), b.Valid, b.Bytes)
+}
+
+// HMAC returns the HMAC-SHA256 of the underlying value using the given key, or
+// NULL if the value is NULL.
+func (b Binary) HMAC(key []byte) Binary {
+	return digest(hmac.New(sha256.New, key), b.Valid, b.Bytes)
+}
+
+func digest(h hash.Hash, valid bool, data []byte) Binary {
+	// Like HexString(), never look at the payload of a NULL value since it may
+	// hold stale data.
+	if !valid {
+		return Binary{}
+	}
+	h.Write(data)
+
+	return NewBinary(h.Sum(nil))
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import "testing"
+
+func TestStringDigest(t *testing.T) {
+	key := []byte("key")
+
+	testCases := []struct {
+		label   string
+		subject String
+		want    [4]string
+	}{
+		{"with NULL string", String{Valid: false}, [4]string{}},
+		{"with NULL string + non-empty string", String{String: "sneaky", Valid: false}, [4]string{}},
+		{
+			"with empty string",
+			NewString(""),
+			[4]string{
+				"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+				"da39a3ee5e6b4b0d3255bfef95601890afd80709",
+				"d41d8cd98f00b204e9800998ecf8427e",
+				"5d5d139563c95b5967b9bd9a8c9b233a9dedb45072794cd232dc1b74832607d0",
+			},
+		},
+		{
+			"with non-empty string",
+			NewString("hello"),
+			[4]string{
+				"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+				"aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d",
+				"5d41402abc4b2a76b9719d911017c592",
+				"9307b3b915efb5171ff14d8cb55fbcc798c6c0ef1456d66ded1a6aa723a58b7b",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res := [4]Binary{tc.subject.SHA256(), tc.subject.SHA1(), tc.subject.MD5(), tc.subject.HMAC(key)}
+
+			for i, r := range res {
+				if r.Valid != tc.subject.Valid {
+					t.Errorf("got: %v, want: %v", r.Valid, tc.subject.Valid)
+					return
+				}
+				if r.HexString() != tc.want[i] {
+					t.Errorf("got: %v, want: %v", r.HexString(), tc.want[i])
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestBinaryDigest(t *testing.T) {
+	key := []byte("key")
+
+	testCases := []struct {
+		label   string
+		subject Binary
+		want    [4]string
+	}{
+		{"with NULL binary", Binary{Valid: false}, [4]string{}},
+		{"with NULL binary + non-empty bytes", Binary{Bytes: []byte("sneaky"), Valid: false}, [4]string{}},
+		{
+			"with non-empty bytes",
+			NewBinary([]byte("hello")),
+			[4]string{
+				"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+				"aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d",
+				"5d41402abc4b2a76b9719d911017c592",
+				"9307b3b915efb5171ff14d8cb55fbcc798c6c0ef1456d66ded1a6aa723a58b7b",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res := [4]Binary{tc.subject.SHA256(), tc.subject.SHA1(), tc.subject.MD5(), tc.subject.HMAC(key)}
+
+			for i, r := range res {
+				if r.Valid != tc.subject.Valid {
+					t.Errorf("got: %v, want: %v", r.Valid, tc.subject.Valid)
+					return
+				}
+				if r.HexString() != tc.want[i] {
+					t.Errorf("got: %v, want: %v", r.HexString(), tc.want[i])
+					return
+				}
+			}
+		})
+	}
+}
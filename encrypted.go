@@ -0,0 +1,342 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// encryptedVersion identifies the layout of the payload produced by
+// Encrypted.Value, which is the version byte, the length of the key ID, the
+// key ID, the GCM nonce and finally the sealed plaintext.
+const encryptedVersion = 1
+
+var (
+	// ErrNoKeyProvider is returned when an Encrypted value is used before a
+	// KeyProvider has been registered.
+	ErrNoKeyProvider = errors.New("nullable: no key provider registered")
+
+	// ErrUnknownKey is returned by a KeyProvider that does not hold the
+	// requested key.
+	ErrUnknownKey = errors.New("nullable: unknown encryption key")
+
+	// ErrMalformedCiphertext is returned when a scanned payload was not
+	// produced by Encrypted.Value.
+	ErrMalformedCiphertext = errors.New("nullable: malformed ciphertext")
+)
+
+var (
+	keyProviderMu sync.RWMutex
+	keyProvider   KeyProvider
+)
+
+// KeyProvider supplies the AES keys used by Encrypted. Each ciphertext
+// records the ID of the key that sealed it, so rotating the current key does
+// not prevent older values from being decrypted as long as the provider still
+// knows about the retired key.
+type KeyProvider interface {
+	// CurrentKey returns the ID and the key used to encrypt new values. The
+	// key must be 16, 24 or 32 bytes long to select AES-128, AES-192 or
+	// AES-256, and the ID must not be longer than 255 bytes.
+	CurrentKey() (id string, key []byte, err error)
+
+	// Key returns the key with the given ID for decryption.
+	Key(id string) ([]byte, error)
+}
+
+// RegisterKeyProvider sets the KeyProvider used by all Encrypted values.
+func RegisterKeyProvider(p KeyProvider) {
+	keyProviderMu.Lock()
+	defer keyProviderMu.Unlock()
+
+	keyProvider = p
+}
+
+func registeredKeyProvider() (KeyProvider, error) {
+	keyProviderMu.RLock()
+	defer keyProviderMu.RUnlock()
+
+	if keyProvider == nil {
+		return nil, ErrNoKeyProvider
+	}
+
+	return keyProvider, nil
+}
+
+// KeyRing is a KeyProvider backed by an in-memory set of keys. To rotate keys,
+// add the new key to Keys and point Current at its ID.
+type KeyRing struct {
+	Current string
+	Keys    map[string][]byte
+}
+
+// CurrentKey implements the KeyProvider interface.
+func (k KeyRing) CurrentKey() (string, []byte, error) {
+	key, err := k.Key(k.Current)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return k.Current, key, nil
+}
+
+// Key implements the KeyProvider interface.
+func (k KeyRing) Key(id string) ([]byte, error) {
+	key, ok := k.Keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, id)
+	}
+
+	return key, nil
+}
+
+// Plaintext is the set of types that an Encrypted value can hold.
+type Plaintext interface {
+	~string | ~[]byte
+}
+
+// Encrypted holds a nullable value that is encrypted with AES-GCM by Value
+// and decrypted by Scan, using the keys of the registered KeyProvider. NULL
+// is stored as NULL rather than as the encryption of an empty value.
+type Encrypted[T Plaintext] struct {
+	V     T
+	Valid bool
+}
+
+// NewEncrypted returns an Encrypted populated with the given plaintext.
+func NewEncrypted[T Plaintext](value T) Encrypted[T] {
+	return Encrypted[T]{V: value, Valid: true}
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *Encrypted[T]) Scan(value any) error {
+	if value == nil {
+		var zero T
+		e.V, e.Valid = zero, false
+		return nil
+	}
+
+	var src []byte
+
+	switch v := value.(type) {
+	case []byte:
+		src = v
+	case string:
+		src = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan type %T into encrypted", value)
+	}
+
+	plaintext, err := decrypt(src)
+	if err != nil {
+		return err
+	}
+	e.V, e.Valid = T(plaintext), true
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (e Encrypted[T]) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+
+	return encrypt([]byte(e.V))
+}
+
+// Null returns true if the underlying value is NULL.
+func (e Encrypted[T]) Null() bool {
+	return !e.Valid
+}
+
+// Nil is an alias for Null() for those who prefer a more Go-like syntax.
+func (e Encrypted[T]) Nil() bool {
+	return e.Null()
+}
+
+// Equal reports whether e and other hold the same plaintext. All NULL values
+// are equal to each other, and NULL is never equal to a non-NULL value.
+func (e Encrypted[T]) Equal(other Encrypted[T]) bool {
+	if !e.Valid || !other.Valid {
+		return e.Valid == other.Valid
+	}
+
+	return string(e.V) == string(other.V)
+}
+
+// Normalize zeroes the payload of a NULL value.
+func (e *Encrypted[T]) Normalize() {
+	if !e.Valid {
+		*e = Encrypted[T]{}
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. Like Value,
+// it never exposes the plaintext: the result is the standard base64
+// encoding of the ciphertext, and NULL marshals as empty text.
+func (e Encrypted[T]) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return []byte{}, nil
+	}
+
+	ciphertext, err := encrypt([]byte(e.V))
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.AppendEncode(nil, ciphertext), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface by
+// decrypting the output of MarshalText. Empty text unmarshals to NULL.
+func (e *Encrypted[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*e = Encrypted[T]{}
+		return nil
+	}
+
+	ciphertext, err := base64.StdEncoding.AppendDecode(nil, text)
+	if err != nil {
+		return ErrMalformedCiphertext
+	}
+
+	return e.Scan(ciphertext)
+}
+
+// MarshalJSON implements the json.Marshaler interface. The value is written
+// as the JSON string of MarshalText, and NULL as null.
+func (e Encrypted[T]) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return []byte("null"), nil
+	}
+
+	text, err := e.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Encrypted[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*e = Encrypted[T]{}
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+
+	return e.UnmarshalText([]byte(text))
+}
+
+func encrypt(plaintext []byte) ([]byte, error) {
+	p, err := registeredKeyProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	id, key, err := p.CurrentKey()
+	if err != nil {
+		return nil, err
+	}
+	if len(id) > 255 {
+		return nil, fmt.Errorf("nullable: key ID is %d bytes long, at most 255 allowed", len(id))
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, 2+len(id))
+	header = append(header, encryptedVersion, byte(len(id)))
+	header = append(header, id...)
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	// The header is authenticated as additional data so that the key ID can
+	// not be tampered with independently of the ciphertext. The output gets
+	// its own buffer, since cipher.AEAD forbids dst from overlapping the
+	// additional data.
+	out := make([]byte, 0, len(header)+len(nonce)+len(plaintext)+aead.Overhead())
+	out = append(out, header...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, plaintext, header), nil
+}
+
+func decrypt(payload []byte) ([]byte, error) {
+	if len(payload) < 2 || payload[0] != encryptedVersion {
+		return nil, ErrMalformedCiphertext
+	}
+
+	idLen := int(payload[1])
+	if len(payload) < 2+idLen {
+		return nil, ErrMalformedCiphertext
+	}
+	header, rest := payload[:2+idLen], payload[2+idLen:]
+
+	p, err := registeredKeyProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := p.Key(string(header[2:]))
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrMalformedCiphertext
+	}
+	nonce, ciphertext := rest[:aead.NonceSize()], rest[aead.NonceSize():]
+
+	return aead.Open(nil, nonce, ciphertext, header)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
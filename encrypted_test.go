@@ -0,0 +1,274 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func withKeyProvider(t *testing.T, p KeyProvider) {
+	t.Helper()
+
+	RegisterKeyProvider(p)
+	t.Cleanup(func() { RegisterKeyProvider(nil) })
+}
+
+func testKeyRing() KeyRing {
+	return KeyRing{
+		Current: "k1",
+		Keys: map[string][]byte{
+			"k1": bytes.Repeat([]byte{1}, 32),
+			"k2": bytes.Repeat([]byte{2}, 16),
+		},
+	}
+}
+
+func TestEncryptedRoundTrip(t *testing.T) {
+	withKeyProvider(t, testKeyRing())
+
+	testCases := []struct {
+		label   string
+		subject Encrypted[string]
+	}{
+		{"with NULL string", Encrypted[string]{}},
+		{"with empty string", NewEncrypted("")},
+		{"with non-empty string", NewEncrypted("jane@example.com")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			v, err := tc.subject.Value()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if !tc.subject.Valid {
+				if v != nil {
+					t.Errorf("got: %v, want: %v", v, nil)
+				}
+				return
+			}
+			if bytes.Contains(v.([]byte), []byte(tc.subject.V)) && len(tc.subject.V) > 0 {
+				t.Errorf("plaintext leaked into payload: %x", v)
+				return
+			}
+
+			var res Encrypted[string]
+			if err := res.Scan(v); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res != tc.subject {
+				t.Errorf("got: %v, want: %v", res, tc.subject)
+				return
+			}
+		})
+	}
+}
+
+func TestEncryptedScanNull(t *testing.T) {
+	res := NewEncrypted([]byte("stale"))
+
+	if err := res.Scan(nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !res.Null() || !res.Nil() || res.V != nil {
+		t.Errorf("got: %v, want: NULL", res)
+		return
+	}
+}
+
+func TestEncryptedKeyRotation(t *testing.T) {
+	ring := testKeyRing()
+	withKeyProvider(t, ring)
+
+	old, err := NewEncrypted([]byte("secret")).Value()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	ring.Current = "k2"
+	RegisterKeyProvider(ring)
+
+	cur, err := NewEncrypted([]byte("secret")).Value()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	for _, payload := range []any{old, string(cur.([]byte))} {
+		var res Encrypted[[]byte]
+		if err := res.Scan(payload); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if string(res.V) != "secret" {
+			t.Errorf("got: %v, want: %v", string(res.V), "secret")
+			return
+		}
+	}
+
+	delete(ring.Keys, "k1")
+
+	var res Encrypted[[]byte]
+	if err := res.Scan(old); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("got: %v, want: %v", err, ErrUnknownKey)
+		return
+	}
+}
+
+func TestEncryptedScanErrors(t *testing.T) {
+	withKeyProvider(t, testKeyRing())
+
+	valid, err := NewEncrypted("hello").Value()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	tampered := bytes.Clone(valid.([]byte))
+	tampered[len(tampered)-1] ^= 0xff
+
+	relabeled := bytes.Clone(valid.([]byte))
+	relabeled[3] = '2'
+
+	testCases := []struct {
+		label   string
+		input   any
+		wantErr error
+	}{
+		{"with invalid type", 42, nil},
+		{"with empty payload", []byte{}, ErrMalformedCiphertext},
+		{"with unknown version", []byte{0xff, 0}, ErrMalformedCiphertext},
+		{"with truncated key ID", []byte{encryptedVersion, 5, 'k'}, ErrMalformedCiphertext},
+		{"with truncated ciphertext", valid.([]byte)[:10], ErrMalformedCiphertext},
+		{"with tampered ciphertext", tampered, nil},
+		{"with tampered key ID", relabeled, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var res Encrypted[string]
+
+			err := res.Scan(tc.input)
+			if err == nil {
+				t.Error("expected an error")
+				return
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestEncryptedWithoutKeyProvider(t *testing.T) {
+	withKeyProvider(t, nil)
+
+	if _, err := NewEncrypted("hello").Value(); !errors.Is(err, ErrNoKeyProvider) {
+		t.Errorf("got: %v, want: %v", err, ErrNoKeyProvider)
+		return
+	}
+	if v, err := (Encrypted[string]{}).Value(); v != nil || err != nil {
+		t.Errorf("got: %v, %v, want: %v, %v", v, err, nil, nil)
+		return
+	}
+}
+
+func TestEncryptedEqual(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Encrypted[[]byte]
+		other   Encrypted[[]byte]
+		want    bool
+	}{
+		{"with both NULL", Encrypted[[]byte]{}, Encrypted[[]byte]{V: []byte("stale")}, true},
+		{"with NULL and empty value", Encrypted[[]byte]{}, NewEncrypted([]byte{}), false},
+		{"with same values", NewEncrypted([]byte("a")), NewEncrypted([]byte("a")), true},
+		{"with different values", NewEncrypted([]byte("a")), NewEncrypted([]byte("b")), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.Equal(tc.other); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestEncryptedJSON(t *testing.T) {
+	withKeyProvider(t, testKeyRing())
+
+	type row struct {
+		SSN  Encrypted[string] `json:"ssn"`
+		Note Encrypted[[]byte] `json:"note"`
+	}
+
+	subject := row{SSN: NewEncrypted("123-45-6789")}
+
+	for label, marshal := range map[string]func(any) ([]byte, error){"json": json.Marshal, "nullable": MarshalJSON} {
+		t.Run(label, func(t *testing.T) {
+			out, err := marshal(subject)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if bytes.Contains(out, []byte("123-45-6789")) || !bytes.Contains(out, []byte(`"note":null`)) {
+				t.Errorf("got: %s, want ciphertext and null", out)
+				return
+			}
+
+			var res row
+			if err := json.Unmarshal(out, &res); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if !res.SSN.Equal(subject.SSN) || !res.Note.Null() {
+				t.Errorf("got: %+v, want: %+v", res, subject)
+				return
+			}
+		})
+	}
+
+	text, err := subject.SSN.MarshalText()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if bytes.Contains(text, []byte("123-45-6789")) {
+		t.Errorf("plaintext leaked into text: %s", text)
+		return
+	}
+
+	var res Encrypted[string]
+	if err := res.UnmarshalText([]byte("not base64!")); !errors.Is(err, ErrMalformedCiphertext) {
+		t.Errorf("got: %v, want: %v", err, ErrMalformedCiphertext)
+		return
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeResult is the result set a fakeDriver returns for a query. If err is
+// set, it is returned once the rows are exhausted.
+type fakeResult struct {
+	columns []string
+	rows    [][]driver.Value
+	err     error
+}
+
+// fakeDriver is a database/sql driver whose queries return canned results,
+// registered per data source name by openFakeDB.
+type fakeDriver struct{}
+
+var (
+	fakeMu       sync.Mutex
+	fakeResults  = make(map[string]map[string]fakeResult)
+	fakeOpenRows atomic.Int32
+)
+
+func init() {
+	sql.Register("nullable-fake", fakeDriver{})
+}
+
+// openFakeDB returns a database whose queries return the given results.
+func openFakeDB(t *testing.T, results map[string]fakeResult) *sql.DB {
+	t.Helper()
+
+	fakeMu.Lock()
+	fakeResults[t.Name()] = results
+	fakeMu.Unlock()
+
+	db, err := sql.Open("nullable-fake", t.Name())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+		fakeMu.Lock()
+		delete(fakeResults, t.Name())
+		fakeMu.Unlock()
+	})
+
+	return db
+}
+
+// query runs query against db, failing the test on error.
+func query(t *testing.T, db *sql.DB, query string) *sql.Rows {
+	t.Helper()
+
+	rows, err := db.Query(query)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return rows
+}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fakeMu.Lock()
+	defer fakeMu.Unlock()
+
+	results, ok := fakeResults[name]
+	if !ok {
+		return nil, fmt.Errorf("fake: unknown database %q", name)
+	}
+
+	return &fakeConn{results: results}, nil
+}
+
+type fakeConn struct {
+	results map[string]fakeResult
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	result, ok := c.results[query]
+	if !ok {
+		return nil, fmt.Errorf("fake: unknown query %q", query)
+	}
+
+	return &fakeStmt{result: result}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fake: transactions are not supported")
+}
+
+type fakeStmt struct {
+	result fakeResult
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("fake: exec is not supported")
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	fakeOpenRows.Add(1)
+	return &fakeRows{result: s.result}, nil
+}
+
+type fakeRows struct {
+	result fakeResult
+	next   int
+	closed bool
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.result.columns
+}
+
+func (r *fakeRows) Close() error {
+	if !r.closed {
+		r.closed = true
+		fakeOpenRows.Add(-1)
+	}
+
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next == len(r.result.rows) {
+		if r.result.err != nil {
+			return r.result.err
+		}
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.next])
+	r.next++
+
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// null is how NULL values are printed by fmt.
+const null = "NULL"
+
+// Format implements the fmt.Formatter interface. The value is printed as if
+// it was a plain string, and NULL is printed as NULL. The %x and %X verbs
+// print the HexString() representation, and %#v prints a Go expression
+// that constructs the value.
+func (s String) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, s.Valid, s.String, s.HexString, `nullable.NewString(%#v)`, "nullable.String{}")
+}
+
+// Format implements the fmt.Formatter interface. The value is printed as if
+// it was a plain int64, and NULL is printed as NULL. The %x and %X verbs
+// print the HexString() representation, and %#v prints a Go expression that
+// constructs the value.
+func (i Int64) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, i.Valid, i.Int64, i.HexString, `nullable.NewInt64(%#v)`, "nullable.Int64{}")
+}
+
+// Format implements the fmt.Formatter interface. The value is printed as if
+// it was a plain int32, and NULL is printed as NULL. The %x and %X verbs
+// print the HexString() representation, and %#v prints a Go expression that
+// constructs the value.
+func (i Int32) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, i.Valid, i.Int32, i.HexString, `nullable.NewInt32(%#v)`, "nullable.Int32{}")
+}
+
+// Format implements the fmt.Formatter interface. The value is printed as if
+// it was a plain int16, and NULL is printed as NULL. The %x and %X verbs
+// print the HexString() representation, and %#v prints a Go expression that
+// constructs the value.
+func (i Int16) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, i.Valid, i.Int16, i.HexString, `nullable.NewInt16(%#v)`, "nullable.Int16{}")
+}
+
+// Format implements the fmt.Formatter interface. The value is printed as if
+// it was a plain byte, and NULL is printed as NULL. %#v prints a Go
+// expression that constructs the value.
+func (b Byte) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, b.Valid, b.Byte, nil, `nullable.NewByte(%#v)`, "nullable.Byte{}")
+}
+
+// Format implements the fmt.Formatter interface. The value is printed as if
+// it was a plain time.Time, and NULL is printed as NULL. %#v prints a Go
+// expression that constructs the value.
+func (t Time) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, t.Valid, t.Time, nil, `nullable.NewTime(%#v)`, "nullable.Time{}")
+}
+
+// Format implements the fmt.Formatter interface. The value is printed as if
+// it was a plain byte slice, and NULL is printed as NULL. The %x and %X verbs
+// print the HexString() representation, and %#v prints a Go expression that
+// constructs the value.
+func (b Binary) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, b.Valid, b.Bytes, b.HexString, `nullable.NewBinary(%#v)`, "nullable.Binary{}")
+}
+
+// Format implements the fmt.Formatter interface like Int64.Format, except
+// that %#v prints a QuotedInt64 expression.
+func (q QuotedInt64) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, q.Valid, q.Int64, Int64(q).HexString, `nullable.NewQuotedInt64(%#v)`, "nullable.QuotedInt64{}")
+}
+
+// Format implements the fmt.Formatter interface. The plaintext is never
+// printed: a valid value is printed as [REDACTED] for every verb, including
+// %+v and %#v, and NULL is printed as NULL.
+func (e Encrypted[T]) Format(f fmt.State, verb rune) {
+	res := redacted
+	if !e.Valid {
+		res = null
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, 's'), res)
+}
+
+// formatValue prints the payload v of a nullable value according to verb and
+// the flags of f. The hex function is optional, and constructor is a format
+// string that turns the Go-syntax representation of v into an expression
+// that evaluates to the nullable value. Like fmt, %#x and %#X prefix a
+// non-empty hexadecimal form with 0x or 0X.
+func formatValue(f fmt.State, verb rune, valid bool, v any, hex func() string, constructor, zero string) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		if !valid {
+			io.WriteString(f, zero)
+			return
+		}
+		fmt.Fprintf(f, constructor, v)
+	case (verb == 'x' || verb == 'X') && (!valid || hex != nil):
+		// Like HexString(), the hexadecimal form of NULL is empty.
+		if !valid {
+			return
+		}
+
+		res := hex()
+		if f.Flag('#') && res != "" {
+			// Like fmt, the 0x prefix goes after the sign of a negative number.
+			if digits, ok := strings.CutPrefix(res, "-"); ok {
+				res = "-0x" + digits
+			} else {
+				res = "0x" + res
+			}
+		}
+		if verb == 'X' {
+			res = strings.ToUpper(res)
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), res)
+	case !valid:
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), null)
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), v)
+	}
+}
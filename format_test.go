@@ -0,0 +1,101 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	date := time.Date(2012, 12, 12, 12, 12, 12, 0, time.UTC)
+
+	testCases := []struct {
+		label  string
+		format string
+		input  any
+		want   string
+	}{
+		{"String %v", "%v", NewString("hello"), "hello"},
+		{"String %s", "%s", NewString("hello"), "hello"},
+		{"String %q", "%q", NewString("hello"), `"hello"`},
+		{"String %x", "%x", NewString("hello"), "68656c6c6f"},
+		{"String %X", "%X", NewString("hello"), "68656C6C6F"},
+		{"String %8v", "%8v", NewString("hello"), "   hello"},
+		{"String %#v", "%#v", NewString("hello"), `nullable.NewString("hello")`},
+		{"String %+v", "%+v", NewString("hello"), "hello"},
+		{"NULL String %v", "%v", String{String: "stale"}, "NULL"},
+		{"NULL String %q", "%q", String{String: "stale"}, "NULL"},
+		{"NULL String %x", "%x", String{String: "stale"}, ""},
+		{"NULL String %-6v", "%-6v|", String{}, "NULL  |"},
+		{"NULL String %#v", "%#v", String{String: "stale"}, "nullable.String{}"},
+		{"Int64 %v", "%v", NewInt64(-255), "-255"},
+		{"Int64 %d", "%05d", NewInt64(42), "00042"},
+		{"Int64 %x", "%x", NewInt64(-255), "-ff"},
+		{"Int64 %#x", "%#x", NewInt64(-255), "-0xff"},
+		{"Int64 %#v", "%#v", NewInt64(42), "nullable.NewInt64(42)"},
+		{"NULL Int64 %d", "%d", Int64{Int64: 42}, "NULL"},
+		{"NULL Int64 %#v", "%#v", Int64{}, "nullable.Int64{}"},
+		{"Int32 %v", "%v", NewInt32(7), "7"},
+		{"Int32 %#v", "%#v", NewInt32(7), "nullable.NewInt32(7)"},
+		{"NULL Int32 %v", "%v", Int32{}, "NULL"},
+		{"Int16 %X", "%X", NewInt16(255), "FF"},
+		{"NULL Int16 %#x", "%#x", Int16{Int16: 1}, ""},
+		{"Int16 %#v", "%#v", NewInt16(7), "nullable.NewInt16(7)"},
+		{"NULL Int16 %x", "%x", Int16{Int16: 1}, ""},
+		{"Byte %v", "%v", NewByte(7), "7"},
+		{"Byte %x", "%x", NewByte(255), "ff"},
+		{"Byte %#v", "%#v", NewByte(7), "nullable.NewByte(0x7)"},
+		{"NULL Byte %v", "%v", Byte{Byte: 7}, "NULL"},
+		{"NULL Byte %x", "%x", Byte{Byte: 7}, ""},
+		{"Time %v", "%v", NewTime(date), "2012-12-12 12:12:12 +0000 UTC"},
+		{"Time %#v", "%#v", NewTime(date), "nullable.NewTime(time.Date(2012, time.December, 12, 12, 12, 12, 0, time.UTC))"},
+		{"NULL Time %v", "%v", Time{Time: date}, "NULL"},
+		{"NULL Time %#v", "%#v", Time{}, "nullable.Time{}"},
+		{"Binary %s", "%s", NewBinary([]byte("hi")), "hi"},
+		{"Binary %v", "%v", NewBinary([]byte("hi")), "[104 105]"},
+		{"Binary %x", "%x", NewBinary([]byte("hi")), "6869"},
+		{"Binary %#x", "%#x", NewBinary([]byte{1}), "0x01"},
+		{"Binary %#X", "%#X", NewBinary([]byte{0xab}), "0XAB"},
+		{"empty Binary %#x", "%#x", NewBinary([]byte{}), ""},
+		{"Binary %#v", "%#v", NewBinary([]byte("hi")), "nullable.NewBinary([]byte{0x68, 0x69})"},
+		{"NULL Binary %s", "%s", Binary{Bytes: []byte("stale")}, "NULL"},
+		{"NULL Binary %#v", "%#v", Binary{}, "nullable.Binary{}"},
+		{"Encrypted %v", "%v", NewEncrypted("jane@example.com"), "[REDACTED]"},
+		{"Encrypted %s", "%s", NewEncrypted([]byte("jane@example.com")), "[REDACTED]"},
+		{"Encrypted %x", "%x", NewEncrypted("jane@example.com"), "[REDACTED]"},
+		{"Encrypted %#v", "%#v", NewEncrypted("jane@example.com"), "[REDACTED]"},
+		{"NULL Encrypted %v", "%v", Encrypted[string]{V: "stale"}, "NULL"},
+		{"struct %+v with Encrypted", "%+v", struct{ Email Encrypted[string] }{NewEncrypted("jane@example.com")}, "{Email:[REDACTED]}"},
+		{"struct %v", "%v", struct{ A, B String }{NewString("a"), String{}}, "{a NULL}"},
+		{"struct %+v", "%+v", struct{ A, B Int64 }{NewInt64(1), Int64{}}, "{A:1 B:NULL}"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := fmt.Sprintf(tc.format, tc.input); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
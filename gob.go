@@ -0,0 +1,455 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// wireVersion is the version of the compact binary format produced by
+// AppendBinary and friends. Every encoded value starts with a header byte
+// holding the version in the upper four bits and a validity flag in the
+// lowest bit. NULL values consist of the header alone, while valid values
+// are followed by their payload: raw bytes for String and Binary, a varint
+// for the integer types, a single byte for Byte, the output of
+// time.Time.MarshalBinary for Time and the ciphertext stored by Value for
+// Encrypted.
+const wireVersion = 1
+
+// errMalformedWire is returned when decoding data that does not match the
+// layout of its type.
+var errMalformedWire = errors.New("nullable: malformed binary data")
+
+// ErrSecretEncoding is returned when a Secret is asked for a binary or gob
+// encoding, which would otherwise carry the plaintext or lose it silently.
+var ErrSecretEncoding = errors.New("nullable: secrets can not be binary encoded")
+
+func appendHeader(b []byte, valid bool) []byte {
+	header := byte(wireVersion << 4)
+	if valid {
+		header |= 1
+	}
+
+	return append(b, header)
+}
+
+// parseHeader validates the header byte of data and returns the payload that
+// follows it. The payload of NULL must be empty.
+func parseHeader(data []byte) ([]byte, bool, error) {
+	if len(data) == 0 {
+		return nil, false, errMalformedWire
+	}
+	if v := data[0] >> 4; v != wireVersion {
+		return nil, false, fmt.Errorf("nullable: unsupported binary format version %d", v)
+	}
+
+	valid := data[0]&1 == 1
+	if !valid && len(data) > 1 {
+		return nil, false, errMalformedWire
+	}
+
+	return data[1:], valid, nil
+}
+
+// parseVarint decodes a payload that consists of exactly one varint within
+// the given bounds.
+func parseVarint(payload []byte, lo, hi int64) (int64, error) {
+	v, n := binary.Varint(payload)
+	if n <= 0 || n != len(payload) || v < lo || v > hi {
+		return 0, errMalformedWire
+	}
+
+	return v, nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (s String) AppendBinary(b []byte) ([]byte, error) {
+	if !s.Valid {
+		return appendHeader(b, false), nil
+	}
+
+	return append(appendHeader(b, true), s.String...), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (s String) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+// GobEncode implements the gob.GobEncoder interface using the same format as
+// MarshalBinary.
+func (s String) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (s *String) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (s *String) UnmarshalBinary(data []byte) error {
+	payload, valid, err := parseHeader(data)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*s = String{}
+		return nil
+	}
+	*s = NewString(string(payload))
+
+	return nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (i Int64) AppendBinary(b []byte) ([]byte, error) {
+	if !i.Valid {
+		return appendHeader(b, false), nil
+	}
+
+	return binary.AppendVarint(appendHeader(b, true), i.Int64), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (i Int64) MarshalBinary() ([]byte, error) {
+	return i.AppendBinary(nil)
+}
+
+// GobEncode implements the gob.GobEncoder interface using the same format as
+// MarshalBinary.
+func (i Int64) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (i *Int64) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (i *Int64) UnmarshalBinary(data []byte) error {
+	payload, valid, err := parseHeader(data)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*i = Int64{}
+		return nil
+	}
+
+	v, err := parseVarint(payload, math.MinInt64, math.MaxInt64)
+	if err != nil {
+		return err
+	}
+	*i = NewInt64(v)
+
+	return nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (i Int32) AppendBinary(b []byte) ([]byte, error) {
+	if !i.Valid {
+		return appendHeader(b, false), nil
+	}
+
+	return binary.AppendVarint(appendHeader(b, true), int64(i.Int32)), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (i Int32) MarshalBinary() ([]byte, error) {
+	return i.AppendBinary(nil)
+}
+
+// GobEncode implements the gob.GobEncoder interface using the same format as
+// MarshalBinary.
+func (i Int32) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (i *Int32) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (i *Int32) UnmarshalBinary(data []byte) error {
+	payload, valid, err := parseHeader(data)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*i = Int32{}
+		return nil
+	}
+
+	v, err := parseVarint(payload, math.MinInt32, math.MaxInt32)
+	if err != nil {
+		return err
+	}
+	*i = NewInt32(int32(v))
+
+	return nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (i Int16) AppendBinary(b []byte) ([]byte, error) {
+	if !i.Valid {
+		return appendHeader(b, false), nil
+	}
+
+	return binary.AppendVarint(appendHeader(b, true), int64(i.Int16)), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (i Int16) MarshalBinary() ([]byte, error) {
+	return i.AppendBinary(nil)
+}
+
+// GobEncode implements the gob.GobEncoder interface using the same format as
+// MarshalBinary.
+func (i Int16) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (i *Int16) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (i *Int16) UnmarshalBinary(data []byte) error {
+	payload, valid, err := parseHeader(data)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*i = Int16{}
+		return nil
+	}
+
+	v, err := parseVarint(payload, math.MinInt16, math.MaxInt16)
+	if err != nil {
+		return err
+	}
+	*i = NewInt16(int16(v))
+
+	return nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (b Byte) AppendBinary(buf []byte) ([]byte, error) {
+	if !b.Valid {
+		return appendHeader(buf, false), nil
+	}
+
+	return append(appendHeader(buf, true), b.Byte), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (b Byte) MarshalBinary() ([]byte, error) {
+	return b.AppendBinary(nil)
+}
+
+// GobEncode implements the gob.GobEncoder interface using the same format as
+// MarshalBinary.
+func (b Byte) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (b *Byte) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (b *Byte) UnmarshalBinary(data []byte) error {
+	payload, valid, err := parseHeader(data)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*b = Byte{}
+		return nil
+	}
+	if len(payload) != 1 {
+		return errMalformedWire
+	}
+	*b = NewByte(payload[0])
+
+	return nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (t Time) AppendBinary(b []byte) ([]byte, error) {
+	if !t.Valid {
+		return appendHeader(b, false), nil
+	}
+
+	return t.Time.AppendBinary(appendHeader(b, true))
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(nil)
+}
+
+// GobEncode implements the gob.GobEncoder interface using the same format as
+// MarshalBinary.
+func (t Time) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (t *Time) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	payload, valid, err := parseHeader(data)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*t = Time{}
+		return nil
+	}
+
+	var v time.Time
+	if err := v.UnmarshalBinary(payload); err != nil {
+		return err
+	}
+	*t = NewTime(v)
+
+	return nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (b Binary) AppendBinary(buf []byte) ([]byte, error) {
+	if !b.Valid {
+		return appendHeader(buf, false), nil
+	}
+
+	return append(appendHeader(buf, true), b.Bytes...), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (b Binary) MarshalBinary() ([]byte, error) {
+	return b.AppendBinary(nil)
+}
+
+// GobEncode implements the gob.GobEncoder interface using the same format as
+// MarshalBinary.
+func (b Binary) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (b *Binary) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (b *Binary) UnmarshalBinary(data []byte) error {
+	payload, valid, err := parseHeader(data)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*b = Binary{}
+		return nil
+	}
+
+	// The data may be reused by the caller, so keep a copy like Scan does.
+	*b = NewBinary(append([]byte{}, payload...))
+
+	return nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface. The payload
+// is encrypted exactly as by Value, so the plaintext never appears in the
+// output and decoding requires the same KeyProvider.
+func (e Encrypted[T]) AppendBinary(b []byte) ([]byte, error) {
+	if !e.Valid {
+		return appendHeader(b, false), nil
+	}
+
+	ciphertext, err := encrypt([]byte(e.V))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(appendHeader(b, true), ciphertext...), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (e Encrypted[T]) MarshalBinary() ([]byte, error) {
+	return e.AppendBinary(nil)
+}
+
+// GobEncode implements the gob.GobEncoder interface using the same format as
+// MarshalBinary.
+func (e Encrypted[T]) GobEncode() ([]byte, error) {
+	return e.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (e *Encrypted[T]) GobDecode(data []byte) error {
+	return e.UnmarshalBinary(data)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (e *Encrypted[T]) UnmarshalBinary(data []byte) error {
+	payload, valid, err := parseHeader(data)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		*e = Encrypted[T]{}
+		return nil
+	}
+
+	plaintext, err := decrypt(payload)
+	if err != nil {
+		return err
+	}
+	*e = NewEncrypted(T(plaintext))
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface and always
+// fails with ErrSecretEncoding.
+func (s Secret) MarshalBinary() ([]byte, error) {
+	return nil, ErrSecretEncoding
+}
+
+// GobEncode implements the gob.GobEncoder interface and always fails with
+// ErrSecretEncoding, so that encoding a struct holding a Secret fails loudly
+// rather than dropping the value.
+func (s Secret) GobEncode() ([]byte, error) {
+	return nil, ErrSecretEncoding
+}
@@ -0,0 +1,248 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject encoding.BinaryMarshaler
+		want    []byte
+	}{
+		{"with NULL String", String{String: "stale"}, []byte{0x10}},
+		{"with empty String", NewString(""), []byte{0x11}},
+		{"with String", NewString("hi"), []byte{0x11, 'h', 'i'}},
+		{"with NULL Int64", Int64{Int64: 1}, []byte{0x10}},
+		{"with Int64", NewInt64(-1), []byte{0x11, 0x01}},
+		{"with Int64 maximum", NewInt64(math.MaxInt64), []byte{0x11, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+		{"with Int32", NewInt32(1), []byte{0x11, 0x02}},
+		{"with Int16", NewInt16(64), []byte{0x11, 0x80, 0x01}},
+		{"with NULL Byte", Byte{}, []byte{0x10}},
+		{"with Byte", NewByte(0xff), []byte{0x11, 0xff}},
+		{"with NULL Time", Time{Time: time.Now()}, []byte{0x10}},
+		{"with NULL Binary", Binary{Bytes: []byte("stale")}, []byte{0x10}},
+		{"with Binary", NewBinary([]byte{0, 1}), []byte{0x11, 0, 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := tc.subject.MarshalBinary()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if !slices.Equal(res, tc.want) {
+				t.Errorf("got: %x, want: %x", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestAppendBinary(t *testing.T) {
+	res, err := NewInt16(1).AppendBinary([]byte("prefix"))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	res, err = NewString("x").AppendBinary(res)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	if want := []byte("prefix\x11\x02\x11x"); !slices.Equal(res, want) {
+		t.Errorf("got: %x, want: %x", res, want)
+		return
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject encoding.BinaryUnmarshaler
+		input   []byte
+	}{
+		{"with empty data", &String{}, []byte{}},
+		{"with unknown version", &String{}, []byte{0x21, 'h'}},
+		{"with payload after NULL", &Binary{}, []byte{0x10, 0}},
+		{"with missing varint", &Int64{}, []byte{0x11}},
+		{"with trailing varint data", &Int64{}, []byte{0x11, 0x02, 0x02}},
+		{"with out of range Int32", &Int32{}, binaryOf(NewInt64(math.MaxInt32 + 1))},
+		{"with out of range Int16", &Int16{}, binaryOf(NewInt64(math.MinInt16 - 1))},
+		{"with missing Byte", &Byte{}, []byte{0x11}},
+		{"with extra Byte", &Byte{}, []byte{0x11, 1, 2}},
+		{"with truncated Time", &Time{}, []byte{0x11, 1, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if err := tc.subject.UnmarshalBinary(tc.input); err == nil {
+				t.Error("expected an error")
+				return
+			}
+		})
+	}
+}
+
+func binaryOf(v encoding.BinaryMarshaler) []byte {
+	b, _ := v.MarshalBinary()
+	return b
+}
+
+type gobRow struct {
+	Name   String
+	Big    Int64
+	Medium Int32
+	Small  Int16
+	Flag   Byte
+	Born   Time
+	Avatar Binary
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	date := time.Date(2012, 12, 12, 12, 12, 12, 12, tokyo)
+
+	testCases := []struct {
+		label   string
+		subject gobRow
+	}{
+		{"with NULLs", gobRow{Name: String{String: "stale"}, Big: Int64{Int64: 1}}},
+		{"with zero values", gobRow{NewString(""), NewInt64(0), NewInt32(0), NewInt16(0), NewByte(0), NewTime(time.Time{}), NewBinary([]byte{})}},
+		{
+			"with values",
+			gobRow{
+				NewString("hello"),
+				NewInt64(math.MinInt64),
+				NewInt32(math.MaxInt32),
+				NewInt16(math.MinInt16),
+				NewByte(0xff),
+				NewTime(date),
+				NewBinary([]byte("hi")),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(tc.subject); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			var res gobRow
+			if err := gob.NewDecoder(&buf).Decode(&res); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			want := tc.subject
+			if !res.Name.Equal(want.Name) || !res.Big.Equal(want.Big) || !res.Medium.Equal(want.Medium) ||
+				!res.Small.Equal(want.Small) || !res.Flag.Equal(want.Flag) || !res.Avatar.Equal(want.Avatar) {
+				t.Errorf("got: %+v, want: %+v", res, want)
+				return
+			}
+			// The binary form of time.Time keeps the zone offset but not its name.
+			_, resOffset := res.Born.Time.Zone()
+			_, wantOffset := want.Born.Time.Zone()
+			if !res.Born.Equal(want.Born) || resOffset != wantOffset {
+				t.Errorf("got: %v, want: %v", res.Born, want.Born)
+				return
+			}
+		})
+	}
+}
+
+func TestUnmarshalBinaryCopiesBytes(t *testing.T) {
+	var b Binary
+
+	src := []byte{0x11, 'h', 'i'}
+	if err := b.UnmarshalBinary(src); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	src[1] = 'x'
+
+	if b.Bytes[0] == 'x' {
+		t.Error("original bytes still referenced")
+		return
+	}
+}
+
+func TestGobEncrypted(t *testing.T) {
+	withKeyProvider(t, testKeyRing())
+
+	type row struct {
+		Email Encrypted[string]
+		Note  Encrypted[[]byte]
+	}
+
+	subject := row{Email: NewEncrypted("jane@example.com")}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(subject); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if bytes.Contains(buf.Bytes(), []byte("jane@example.com")) {
+		t.Errorf("plaintext leaked: %q", buf.Bytes())
+		return
+	}
+
+	var res row
+	if err := gob.NewDecoder(&buf).Decode(&res); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !res.Email.Equal(subject.Email) || !res.Note.Null() {
+		t.Errorf("got: %+v, want: %+v", res, subject)
+		return
+	}
+}
+
+func TestGobSecret(t *testing.T) {
+	subject := struct {
+		Token Secret
+	}{NewSecret([]byte("hunter2"))}
+
+	if err := gob.NewEncoder(&bytes.Buffer{}).Encode(subject); !errors.Is(err, ErrSecretEncoding) {
+		t.Errorf("got: %v, want: %v", err, ErrSecretEncoding)
+		return
+	}
+	if _, err := subject.Token.MarshalBinary(); !errors.Is(err, ErrSecretEncoding) {
+		t.Errorf("got: %v, want: %v", err, ErrSecretEncoding)
+		return
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+)
+
+// The GraphQL methods match the shapes of the Marshaler and Unmarshaler
+// interfaces of gqlgen, so that no GraphQL package needs to be imported. NULL
+// is written as null, and a nil input unmarshals to NULL.
+
+// MarshalGQL implements the graphql.Marshaler interface.
+func (s String) MarshalGQL(w io.Writer) {
+	if !s.Valid {
+		io.WriteString(w, "null")
+		return
+	}
+	writeGQLString(w, s.String)
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface.
+func (s *String) UnmarshalGQL(v any) error {
+	switch v := v.(type) {
+	case nil:
+		*s = String{}
+	case string:
+		*s = NewString(v)
+	default:
+		return fmt.Errorf("cannot unmarshal %T into string", v)
+	}
+
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface. The value is written
+// as a number; since the GraphQL Int scalar is limited to 32 bits, use
+// QuotedInt64 for fields that are exposed as strings instead.
+func (i Int64) MarshalGQL(w io.Writer) {
+	if !i.Valid {
+		io.WriteString(w, "null")
+		return
+	}
+	io.WriteString(w, strconv.FormatInt(i.Int64, 10))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface.
+func (i *Int64) UnmarshalGQL(v any) error {
+	return unmarshalGQLInt(v, math.MinInt64, math.MaxInt64, i, func(n int64) {
+		*i = NewInt64(n)
+	})
+}
+
+// MarshalGQL implements the graphql.Marshaler interface. The value is written
+// as a string, which keeps 64-bit values intact where the GraphQL Int scalar
+// can not hold them.
+func (q QuotedInt64) MarshalGQL(w io.Writer) {
+	if !q.Valid {
+		io.WriteString(w, "null")
+		return
+	}
+	writeGQLString(w, strconv.FormatInt(q.Int64, 10))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface. Like Int64, both
+// strings and numbers are accepted.
+func (q *QuotedInt64) UnmarshalGQL(v any) error {
+	return (*Int64)(q).UnmarshalGQL(v)
+}
+
+// MarshalGQL implements the graphql.Marshaler interface.
+func (i Int32) MarshalGQL(w io.Writer) {
+	if !i.Valid {
+		io.WriteString(w, "null")
+		return
+	}
+	io.WriteString(w, strconv.FormatInt(int64(i.Int32), 10))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface.
+func (i *Int32) UnmarshalGQL(v any) error {
+	return unmarshalGQLInt(v, math.MinInt32, math.MaxInt32, i, func(n int64) {
+		*i = NewInt32(int32(n))
+	})
+}
+
+// MarshalGQL implements the graphql.Marshaler interface.
+func (i Int16) MarshalGQL(w io.Writer) {
+	if !i.Valid {
+		io.WriteString(w, "null")
+		return
+	}
+	io.WriteString(w, strconv.FormatInt(int64(i.Int16), 10))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface.
+func (i *Int16) UnmarshalGQL(v any) error {
+	return unmarshalGQLInt(v, math.MinInt16, math.MaxInt16, i, func(n int64) {
+		*i = NewInt16(int16(n))
+	})
+}
+
+// MarshalGQL implements the graphql.Marshaler interface.
+func (b Byte) MarshalGQL(w io.Writer) {
+	if !b.Valid {
+		io.WriteString(w, "null")
+		return
+	}
+	io.WriteString(w, strconv.FormatUint(uint64(b.Byte), 10))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface.
+func (b *Byte) UnmarshalGQL(v any) error {
+	return unmarshalGQLInt(v, 0, math.MaxUint8, b, func(n int64) {
+		*b = NewByte(byte(n))
+	})
+}
+
+// MarshalGQL implements the graphql.Marshaler interface. The value is written
+// as an RFC 3339 string.
+func (t Time) MarshalGQL(w io.Writer) {
+	if !t.Valid {
+		io.WriteString(w, "null")
+		return
+	}
+	writeGQLString(w, t.Time.Format(time.RFC3339Nano))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface. The input must be
+// an RFC 3339 string or a time.Time.
+func (t *Time) UnmarshalGQL(v any) error {
+	switch v := v.(type) {
+	case nil:
+		*t = Time{}
+	case time.Time:
+		*t = NewTime(v)
+	case string:
+		return t.parseText(v)
+	default:
+		return fmt.Errorf("cannot unmarshal %T into time", v)
+	}
+
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface. The value is written
+// as a base64 string.
+func (b Binary) MarshalGQL(w io.Writer) {
+	text, ok := b.text()
+	if !ok {
+		io.WriteString(w, "null")
+		return
+	}
+	writeGQLString(w, text)
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface. The input must be
+// a base64 string.
+func (b *Binary) UnmarshalGQL(v any) error {
+	switch v := v.(type) {
+	case nil:
+		*b = Binary{}
+	case string:
+		return b.parseText(v)
+	default:
+		return fmt.Errorf("cannot unmarshal %T into binary", v)
+	}
+
+	return nil
+}
+
+func writeGQLString(w io.Writer, s string) {
+	// GraphQL string literals share their escape sequences with JSON, and
+	// marshaling a string can not fail.
+	b, _ := json.Marshal(s)
+	w.Write(b)
+}
+
+// unmarshalGQLInt converts the integer representations that GraphQL servers
+// hand to UnmarshalGQL into an int64 within [lo, hi] and passes it to set, or
+// sets dst to NULL if v is nil.
+func unmarshalGQLInt(v any, lo, hi int64, dst textParser, set func(n int64)) error {
+	var n int64
+
+	switch v := v.(type) {
+	case nil:
+		dst.setNull()
+		return nil
+	case int:
+		n = int64(v)
+	case int32:
+		n = int64(v)
+	case int64:
+		n = v
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return fmt.Errorf("cannot unmarshal %v into integer", v)
+		}
+		n = int64(v)
+	case json.Number:
+		var err error
+		if n, err = v.Int64(); err != nil {
+			return err
+		}
+	case string:
+		var err error
+		if n, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("cannot unmarshal %T into integer", v)
+	}
+
+	if n < lo || n > hi {
+		return fmt.Errorf("cannot unmarshal %d: value out of range", n)
+	}
+	set(n)
+
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMarshalGQL(t *testing.T) {
+	date := time.Date(2012, 12, 12, 12, 12, 12, 0, time.UTC)
+
+	testCases := []struct {
+		label   string
+		subject interface{ MarshalGQL(io.Writer) }
+		want    string
+	}{
+		{"with String", NewString("say \"hi\"\n"), `"say \"hi\"\n"`},
+		{"with NULL String", String{String: "stale"}, "null"},
+		{"with Int64", NewInt64(math.MaxInt64), "9223372036854775807"},
+		{"with NULL Int64", Int64{}, "null"},
+		{"with Int32", NewInt32(-1), "-1"},
+		{"with NULL Int32", Int32{}, "null"},
+		{"with Int16", NewInt16(-1), "-1"},
+		{"with NULL Int16", Int16{}, "null"},
+		{"with Byte", NewByte(255), "255"},
+		{"with NULL Byte", Byte{}, "null"},
+		{"with Time", NewTime(date), `"2012-12-12T12:12:12Z"`},
+		{"with NULL Time", Time{}, "null"},
+		{"with Binary", NewBinary([]byte("hi")), `"aGk="`},
+		{"with NULL Binary", Binary{}, "null"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var buf bytes.Buffer
+			tc.subject.MarshalGQL(&buf)
+
+			if res := buf.String(); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestMarshalGQLQuotedInt64(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject QuotedInt64
+		want    string
+	}{
+		{"with maximum", NewQuotedInt64(math.MaxInt64), `"9223372036854775807"`},
+		{"with minimum", NewQuotedInt64(math.MinInt64), `"-9223372036854775808"`},
+		{"with NULL", QuotedInt64{}, "null"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var buf bytes.Buffer
+			tc.subject.MarshalGQL(&buf)
+
+			if res := buf.String(); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+
+			// Round-trip the value the way a GraphQL server would decode it.
+			var input any
+			if err := json.Unmarshal(buf.Bytes(), &input); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			var res QuotedInt64
+			if err := res.UnmarshalGQL(input); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res != tc.subject {
+				t.Errorf("got: %v, want: %v", res, tc.subject)
+				return
+			}
+		})
+	}
+}
+
+func TestUnmarshalGQL(t *testing.T) {
+	date := time.Date(2012, 12, 12, 12, 12, 12, 0, time.UTC)
+
+	type unmarshaler interface {
+		UnmarshalGQL(any) error
+	}
+
+	testCases := []struct {
+		label   string
+		subject unmarshaler
+		input   any
+		want    any
+		wantErr bool
+	}{
+		{"with String", &String{}, "hello", NewString("hello"), false},
+		{"with nil String", &String{String: "stale", Valid: true}, nil, String{}, false},
+		{"with invalid String", &String{}, 1, nil, true},
+		{"with int Int64", &Int64{}, 1, NewInt64(1), false},
+		{"with int64 Int64", &Int64{}, int64(math.MinInt64), NewInt64(math.MinInt64), false},
+		{"with string Int64", &Int64{}, "9223372036854775807", NewInt64(math.MaxInt64), false},
+		{"with json.Number Int64", &Int64{}, json.Number("-5"), NewInt64(-5), false},
+		{"with float64 Int64", &Int64{}, float64(3), NewInt64(3), false},
+		{"with fractional Int64", &Int64{}, 3.5, nil, true},
+		{"with nil Int64", &Int64{Int64: 1, Valid: true}, nil, Int64{}, false},
+		{"with invalid Int64", &Int64{}, true, nil, true},
+		{"with Int32", &Int32{}, int32(-1), NewInt32(-1), false},
+		{"with out of range Int32", &Int32{}, int64(math.MaxInt32 + 1), nil, true},
+		{"with Int16", &Int16{}, "-1", NewInt16(-1), false},
+		{"with out of range Int16", &Int16{}, math.MaxInt16 + 1, nil, true},
+		{"with nil Int16", &Int16{Int16: 1, Valid: true}, nil, Int16{}, false},
+		{"with Byte", &Byte{}, 255, NewByte(255), false},
+		{"with negative Byte", &Byte{}, -1, nil, true},
+		{"with nil Byte", &Byte{Byte: 1, Valid: true}, nil, Byte{}, false},
+		{"with string Time", &Time{}, "2012-12-12T12:12:12Z", NewTime(date), false},
+		{"with time.Time Time", &Time{}, date, NewTime(date), false},
+		{"with invalid Time", &Time{}, 42, nil, true},
+		{"with nil Time", &Time{Time: date, Valid: true}, nil, Time{}, false},
+		{"with invalid Binary", &Binary{}, "!", nil, true},
+		{"with non-string Binary", &Binary{}, 1, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			err := tc.subject.UnmarshalGQL(tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if tc.want == nil {
+				return
+			}
+			if res := reflect.ValueOf(tc.subject).Elem().Interface(); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestUnmarshalGQLBinary(t *testing.T) {
+	var b Binary
+
+	if err := b.UnmarshalGQL("aGk="); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !b.Equal(NewBinary([]byte("hi"))) {
+		t.Errorf("got: %v, want: %v", b, "hi")
+		return
+	}
+
+	if err := b.UnmarshalGQL(nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !b.Null() {
+		t.Errorf("got: %v, want: NULL", b)
+		return
+	}
+}
@@ -61,6 +61,25 @@ func (i Int64) Nil() bool {
 	return i.Null()
 }
 
+// Equal reports whether i and other hold the same value. All NULL values are
+// equal to each other regardless of any stale payload, and NULL is never
+// equal to a non-NULL value.
+func (i Int64) Equal(other Int64) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+
+	return i.Int64 == other.Int64
+}
+
+// Normalize zeroes the payload of a NULL value, so that it compares equal to
+// any other NULL value with ==.
+func (i *Int64) Normalize() {
+	if !i.Valid {
+		*i = Int64{}
+	}
+}
+
 // HexString returns a hexadecimal string representation of the underlying value.
 func (i Int64) HexString() string {
 	if !i.Valid {
@@ -104,6 +123,25 @@ func (i Int32) Nil() bool {
 	return i.Null()
 }
 
+// Equal reports whether i and other hold the same value. All NULL values are
+// equal to each other regardless of any stale payload, and NULL is never
+// equal to a non-NULL value.
+func (i Int32) Equal(other Int32) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+
+	return i.Int32 == other.Int32
+}
+
+// Normalize zeroes the payload of a NULL value, so that it compares equal to
+// any other NULL value with ==.
+func (i *Int32) Normalize() {
+	if !i.Valid {
+		*i = Int32{}
+	}
+}
+
 // HexString returns a hexadecimal string representation of the underlying value.
 func (i Int32) HexString() string {
 	if !i.Valid {
@@ -147,6 +185,25 @@ func (i Int16) Nil() bool {
 	return i.Null()
 }
 
+// Equal reports whether i and other hold the same value. All NULL values are
+// equal to each other regardless of any stale payload, and NULL is never
+// equal to a non-NULL value.
+func (i Int16) Equal(other Int16) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+
+	return i.Int16 == other.Int16
+}
+
+// Normalize zeroes the payload of a NULL value, so that it compares equal to
+// any other NULL value with ==.
+func (i *Int16) Normalize() {
+	if !i.Valid {
+		*i = Int16{}
+	}
+}
+
 // HexString returns a hexadecimal string representation of the underlying value.
 func (i Int16) HexString() string {
 	if !i.Valid {
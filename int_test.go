@@ -307,3 +307,64 @@ func TestInt16HexString(t *testing.T) {
 		})
 	}
 }
+
+func TestIntEqual(t *testing.T) {
+	testCases := []struct {
+		label  string
+		a, b   int16
+		aValid bool
+		bValid bool
+		want   bool
+	}{
+		{"with both NULL", 0, 0, false, false, true},
+		{"with both NULL + stale integers", 1, 2, false, false, true},
+		{"with NULL and zero", 0, 0, false, true, false},
+		{"with zero and NULL", 0, 0, true, false, false},
+		{"with same integers", -1, -1, true, true, true},
+		{"with different integers", 1, 2, true, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			i64, o64 := Int64{Int64: int64(tc.a), Valid: tc.aValid}, Int64{Int64: int64(tc.b), Valid: tc.bValid}
+			if res := i64.Equal(o64); res != tc.want {
+				t.Errorf("Int64 got: %v, want: %v", res, tc.want)
+				return
+			}
+
+			i32, o32 := Int32{Int32: int32(tc.a), Valid: tc.aValid}, Int32{Int32: int32(tc.b), Valid: tc.bValid}
+			if res := i32.Equal(o32); res != tc.want {
+				t.Errorf("Int32 got: %v, want: %v", res, tc.want)
+				return
+			}
+
+			i16, o16 := Int16{Int16: tc.a, Valid: tc.aValid}, Int16{Int16: tc.b, Valid: tc.bValid}
+			if res := i16.Equal(o16); res != tc.want {
+				t.Errorf("Int16 got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestIntNormalize(t *testing.T) {
+	i64, i32, i16 := Int64{Int64: 1}, Int32{Int32: 1}, Int16{Int16: 1}
+	i64.Normalize()
+	i32.Normalize()
+	i16.Normalize()
+
+	if i64 != (Int64{}) || i32 != (Int32{}) || i16 != (Int16{}) {
+		t.Errorf("got: %v %v %v, want: zero values", i64, i32, i16)
+		return
+	}
+
+	v64, v32, v16 := NewInt64(1), NewInt32(1), NewInt16(1)
+	v64.Normalize()
+	v32.Normalize()
+	v16.Normalize()
+
+	if v64 != NewInt64(1) || v32 != NewInt32(1) || v16 != NewInt16(1) {
+		t.Errorf("got: %v %v %v, want: unchanged values", v64, v32, v16)
+		return
+	}
+}
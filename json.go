@@ -0,0 +1,636 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// jsonOptions holds the parsed options of a nullable struct tag.
+type jsonOptions struct {
+	omitNull bool
+	quoted   bool
+	time     string
+	binary   string
+}
+
+// MarshalJSON returns the JSON encoding of v like json.Marshal, except that
+// nullable values are encoded as plain JSON values rather than as objects,
+// with NULL encoded as null. Structs are walked through pointers, slices and
+// arrays, while other values are encoded by json.Marshal. The json struct tag
+// is honored like json.Marshal does, including its omitempty, omitzero and
+// string options.
+//
+// The encoding of a nullable struct field can be adjusted with a nullable
+// struct tag, which is a comma separated list of the following options:
+//
+//	omitnull   leave the field out of the object when it is NULL
+//	string     encode Int64, Int32, Int16 or Byte as a JSON string
+//	rfc3339    encode Time as an RFC 3339 string (default)
+//	unix       encode Time as seconds since the Unix epoch
+//	unixmilli  encode Time as milliseconds since the Unix epoch
+//	base64     encode Binary as a standard base64 string (default)
+//	hex        encode Binary as a hexadecimal string
+//
+// For example:
+//
+//	type Event struct {
+//		At      nullable.Time   `json:"at" nullable:"unixmilli"`
+//		Payload nullable.Binary `json:"payload" nullable:"hex,omitnull"`
+//	}
+func MarshalJSON(v any) ([]byte, error) {
+	var e jsonEncodeState
+
+	if err := e.encode(reflect.ValueOf(v), jsonOptions{}); err != nil {
+		return nil, err
+	}
+
+	return e.Bytes(), nil
+}
+
+// JSONEncoder writes JSON values to an output stream using the encoding of
+// MarshalJSON.
+type JSONEncoder struct {
+	w io.Writer
+}
+
+// NewJSONEncoder returns a JSONEncoder that writes to w.
+func NewJSONEncoder(w io.Writer) *JSONEncoder {
+	return &JSONEncoder{w: w}
+}
+
+// Encode writes the JSON encoding of v to the stream, followed by a newline
+// character like json.Encoder does.
+func (e *JSONEncoder) Encode(v any) error {
+	b, err := MarshalJSON(v)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.w.Write(append(b, '\n'))
+	return err
+}
+
+var (
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	timeType          = reflect.TypeFor[time.Time]()
+)
+
+func parseJSONOptions(tag string, t reflect.Type) (jsonOptions, error) {
+	var opts jsonOptions
+	if tag == "" {
+		return opts, nil
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	for _, opt := range strings.Split(tag, ",") {
+		var ok bool
+
+		switch opt {
+		case "omitnull":
+			opts.omitNull, ok = true, isNullableType(t)
+		case "string":
+			opts.quoted = true
+			ok = t == reflect.TypeFor[Int64]() || t == reflect.TypeFor[Int32]() ||
+				t == reflect.TypeFor[Int16]() || t == reflect.TypeFor[Byte]()
+		case "rfc3339", "unix", "unixmilli":
+			opts.time, ok = opt, t == reflect.TypeFor[Time]()
+		case "base64", "hex":
+			opts.binary, ok = opt, t == reflect.TypeFor[Binary]()
+		default:
+			return opts, fmt.Errorf("nullable: unknown tag option %q", opt)
+		}
+
+		if !ok {
+			return opts, fmt.Errorf("nullable: tag option %q does not apply to %s", opt, t)
+		}
+	}
+
+	return opts, nil
+}
+
+func isNullableType(t reflect.Type) bool {
+	switch t {
+	case reflect.TypeFor[String](), reflect.TypeFor[Int64](), reflect.TypeFor[Int32](),
+		reflect.TypeFor[Int16](), reflect.TypeFor[Byte](), reflect.TypeFor[Time](), reflect.TypeFor[Binary]():
+		return true
+	}
+
+	return false
+}
+
+// jsonEncodeState is the output of MarshalJSON along with the pointers,
+// maps and slices that are being encoded, which are tracked to detect
+// cycles.
+type jsonEncodeState struct {
+	bytes.Buffer
+	seen map[jsonRef]bool
+}
+
+// jsonRef identifies a pointer, map or slice being encoded.
+type jsonRef struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+// enter records that v is being encoded, returning an error like
+// encoding/json does if it already is.
+func (e *jsonEncodeState) enter(v reflect.Value) (jsonRef, error) {
+	ref := jsonRef{ptr: v.Pointer(), typ: v.Type()}
+	if v.Kind() == reflect.Slice {
+		ref.len = v.Len()
+	}
+	if e.seen[ref] {
+		return ref, &json.UnsupportedValueError{Value: v, Str: fmt.Sprintf("encountered a cycle via %s", v.Type())}
+	}
+	if e.seen == nil {
+		e.seen = make(map[jsonRef]bool)
+	}
+	e.seen[ref] = true
+
+	return ref, nil
+}
+
+func (e *jsonEncodeState) encode(v reflect.Value, opts jsonOptions) error {
+	if !v.IsValid() {
+		e.WriteString("null")
+		return nil
+	}
+
+	if isNullableType(v.Type()) {
+		return encodeNullableJSON(&e.Buffer, v.Interface(), opts)
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		return e.encode(v.Elem(), opts)
+	case reflect.Pointer:
+		if v.IsNil() {
+			e.WriteString("null")
+			return nil
+		}
+		if !v.Type().Implements(jsonMarshalerType) {
+			ref, err := e.enter(v)
+			if err != nil {
+				return err
+			}
+			defer delete(e.seen, ref)
+
+			return e.encode(v.Elem(), opts)
+		}
+	case reflect.Struct:
+		if v.Type() != timeType && !v.Type().Implements(jsonMarshalerType) {
+			return e.encodeStruct(v)
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			e.WriteString("null")
+			return nil
+		}
+		if !v.Type().Implements(jsonMarshalerType) {
+			ref, err := e.enter(v)
+			if err != nil {
+				return err
+			}
+			defer delete(e.seen, ref)
+
+			return e.encodeMap(v, opts)
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			e.WriteString("null")
+			return nil
+		}
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			if v.Kind() == reflect.Slice {
+				ref, err := e.enter(v)
+				if err != nil {
+					return err
+				}
+				defer delete(e.seen, ref)
+			}
+
+			e.WriteByte('[')
+			for i := range v.Len() {
+				if i > 0 {
+					e.WriteByte(',')
+				}
+				if err := e.encode(v.Index(i), opts); err != nil {
+					return err
+				}
+			}
+			e.WriteByte(']')
+
+			return nil
+		}
+	}
+
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return err
+	}
+	e.Write(b)
+
+	return nil
+}
+
+// encodeMap writes a JSON object with the entries of the map v, sorted by
+// key like encoding/json does.
+func (e *jsonEncodeState) encodeMap(v reflect.Value, opts jsonOptions) error {
+	type entry struct {
+		key   string
+		value reflect.Value
+	}
+
+	entries := make([]entry, 0, v.Len())
+	for iter := v.MapRange(); iter.Next(); {
+		key, err := jsonMapKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{key, iter.Value()})
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	e.WriteByte('{')
+	for i, ent := range entries {
+		if i > 0 {
+			e.WriteByte(',')
+		}
+		key, _ := json.Marshal(ent.key)
+		e.Write(key)
+		e.WriteByte(':')
+
+		if err := e.encode(ent.value, opts); err != nil {
+			return err
+		}
+	}
+	e.WriteByte('}')
+
+	return nil
+}
+
+// jsonMapKey returns the object key of the map key k, following the rules
+// of encoding/json.
+func jsonMapKey(k reflect.Value) (string, error) {
+	if k.Kind() == reflect.String {
+		return k.String(), nil
+	}
+	if tm, ok := k.Interface().(encoding.TextMarshaler); ok {
+		if k.Kind() == reflect.Pointer && k.IsNil() {
+			return "", nil
+		}
+		b, err := tm.MarshalText()
+		return string(b), err
+	}
+
+	switch k.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(k.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(k.Uint(), 10), nil
+	}
+
+	return "", &json.UnsupportedTypeError{Type: k.Type()}
+}
+
+// jsonField is a struct field that is encoded as a member of a JSON object.
+type jsonField struct {
+	name     string
+	index    []int
+	tagged   bool
+	jsonOpts string
+	field    reflect.StructField
+}
+
+// jsonFields returns the fields of the struct type t that are encoded, in
+// the order encoding/json would write them. Like encoding/json, the fields
+// of embedded structs are promoted into the outer object unless the
+// embedded field is named, and when several fields share a name the
+// shallowest one wins, a tagged field breaks a tie at the same depth, and
+// any other tie drops the name altogether.
+func jsonFields(t reflect.Type) []jsonField {
+	var all []jsonField
+	// Embedded structs that are being walked, so that a struct embedding a
+	// pointer to itself does not recurse forever.
+	walking := map[reflect.Type]bool{t: true}
+
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		for i := range t.NumField() {
+			sf := t.Field(i)
+
+			tag := sf.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, jsonOpts, _ := strings.Cut(tag, ",")
+			idx := append(slices.Clip(index), i)
+
+			if sf.Anonymous && name == "" {
+				et := sf.Type
+				if et.Kind() == reflect.Pointer {
+					et = et.Elem()
+				}
+				if et.Kind() == reflect.Struct && !isNullableType(et) {
+					if !walking[et] {
+						walking[et] = true
+						walk(et, idx)
+						delete(walking, et)
+					}
+					continue
+				}
+			}
+			if !sf.IsExported() {
+				continue
+			}
+
+			tagged := name != ""
+			if !tagged {
+				name = sf.Name
+			}
+			all = append(all, jsonField{name: name, index: idx, tagged: tagged, jsonOpts: jsonOpts, field: sf})
+		}
+	}
+	walk(t, nil)
+
+	byName := make(map[string][]jsonField)
+	for _, f := range all {
+		byName[f.name] = append(byName[f.name], f)
+	}
+
+	var fields []jsonField
+	for _, f := range all {
+		if dominant, ok := dominantJSONField(byName[f.name]); ok && slices.Equal(dominant.index, f.index) {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// dominantJSONField returns the field that wins among fields sharing a
+// name, reporting false if there is no single winner.
+func dominantJSONField(fields []jsonField) (jsonField, bool) {
+	depth := len(fields[0].index)
+	for _, f := range fields {
+		depth = min(depth, len(f.index))
+	}
+
+	var candidates []jsonField
+	var tagged []jsonField
+	for _, f := range fields {
+		if len(f.index) != depth {
+			continue
+		}
+		candidates = append(candidates, f)
+		if f.tagged {
+			tagged = append(tagged, f)
+		}
+	}
+
+	switch {
+	case len(candidates) == 1:
+		return candidates[0], true
+	case len(tagged) == 1:
+		return tagged[0], true
+	}
+
+	return jsonField{}, false
+}
+
+func (e *jsonEncodeState) encodeStruct(v reflect.Value) error {
+	e.WriteByte('{')
+
+	first := true
+
+	for _, f := range jsonFields(v.Type()) {
+		// Like encoding/json, fields promoted through a nil embedded pointer
+		// are left out.
+		fv, err := v.FieldByIndexErr(f.index)
+		if err != nil {
+			continue
+		}
+
+		opts, err := parseJSONOptions(f.field.Tag.Get("nullable"), f.field.Type)
+		if err != nil {
+			return fmt.Errorf("%w in field %s", err, f.field.Name)
+		}
+		if opts.omitNull && isNullJSONValue(fv) {
+			continue
+		}
+		if hasJSONOption(f.jsonOpts, "omitempty") && isEmptyJSONValue(fv) {
+			continue
+		}
+		if hasJSONOption(f.jsonOpts, "omitzero") && isZeroJSONValue(fv) {
+			continue
+		}
+
+		if !first {
+			e.WriteByte(',')
+		}
+		first = false
+
+		key, _ := json.Marshal(f.name)
+		e.Write(key)
+		e.WriteByte(':')
+
+		if hasJSONOption(f.jsonOpts, "string") && isQuotableJSONType(f.field.Type) {
+			if err := encodeQuotedJSON(&e.Buffer, fv); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := e.encode(fv, opts); err != nil {
+			return err
+		}
+	}
+	e.WriteByte('}')
+
+	return nil
+}
+
+// isNullJSONValue reports whether the nullable value v, which may be behind
+// pointers, is NULL or a nil pointer.
+func isNullJSONValue(v reflect.Value) bool {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+
+	return v.Interface().(Nullable).Null()
+}
+
+// hasJSONOption reports whether the comma separated options of a json struct
+// tag include opt.
+func hasJSONOption(opts, opt string) bool {
+	return strings.Contains(","+opts+",", ","+opt+",")
+}
+
+// isZeroJSONValue reports whether v is zero in the sense of the omitzero
+// option of encoding/json, which defers to an IsZero method when the type
+// has one.
+func isZeroJSONValue(v reflect.Value) bool {
+	type zeroer interface {
+		IsZero() bool
+	}
+
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return true
+	}
+	if z, ok := v.Interface().(zeroer); ok {
+		return z.IsZero()
+	}
+	if v.CanAddr() {
+		if z, ok := v.Addr().Interface().(zeroer); ok {
+			return z.IsZero()
+		}
+	}
+
+	return v.IsZero()
+}
+
+// isQuotableJSONType reports whether the string option of encoding/json
+// applies to the type t, which is the case for strings, numbers and
+// booleans, or unnamed pointers to them.
+func isQuotableJSONType(t reflect.Type) bool {
+	if t.Name() == "" && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	}
+
+	return false
+}
+
+// encodeQuotedJSON writes the JSON encoding of v inside a JSON string, like
+// the string option of encoding/json does.
+func encodeQuotedJSON(buf *bytes.Buffer, v reflect.Value) error {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return err
+	}
+	quoted, err := json.Marshal(string(b))
+	if err != nil {
+		return err
+	}
+	buf.Write(quoted)
+
+	return nil
+}
+
+// isEmptyJSONValue reports whether v is empty in the sense of the omitempty
+// option of encoding/json.
+func isEmptyJSONValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+
+	return false
+}
+
+func encodeNullableJSON(buf *bytes.Buffer, v any, opts jsonOptions) error {
+	if v.(Nullable).Null() {
+		buf.WriteString("null")
+		return nil
+	}
+
+	var text string
+	var quote bool
+
+	switch v := v.(type) {
+	case String:
+		text, quote = v.String, true
+	case Int64:
+		text, quote = strconv.FormatInt(v.Int64, 10), opts.quoted
+	case Int32:
+		text, quote = strconv.FormatInt(int64(v.Int32), 10), opts.quoted
+	case Int16:
+		text, quote = strconv.FormatInt(int64(v.Int16), 10), opts.quoted
+	case Byte:
+		text, quote = strconv.FormatUint(uint64(v.Byte), 10), opts.quoted
+	case Time:
+		switch opts.time {
+		case "unix":
+			text = strconv.FormatInt(v.Time.Unix(), 10)
+		case "unixmilli":
+			text = strconv.FormatInt(v.Time.UnixMilli(), 10)
+		default:
+			text, quote = v.Time.Format(time.RFC3339Nano), true
+		}
+	case Binary:
+		if opts.binary == "hex" {
+			text = hex.EncodeToString(v.Bytes)
+		} else {
+			text = base64.StdEncoding.EncodeToString(v.Bytes)
+		}
+		quote = true
+	}
+
+	if !quote {
+		buf.WriteString(text)
+		return nil
+	}
+
+	b, err := json.Marshal(text)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+
+	return nil
+}
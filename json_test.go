@@ -0,0 +1,328 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	date := time.Date(2012, 12, 12, 12, 12, 12, 500_000_000, time.UTC)
+
+	type Audit struct {
+		CreatedAt Time `json:"created_at" nullable:"unix"`
+	}
+
+	type Inner struct {
+		Score Int32 `json:"score"`
+	}
+
+	type Named struct {
+		Name String
+	}
+
+	type Other struct {
+		Name String
+	}
+
+	type Tagged struct {
+		Name String `json:"Name"`
+	}
+
+	testCases := []struct {
+		label string
+		input any
+		want  string
+	}{
+		{
+			"with defaults",
+			struct {
+				Name   String `json:"name"`
+				Big    Int64  `json:"big"`
+				Medium Int32
+				Small  Int16  `json:"small"`
+				Flag   Byte   `json:"flag"`
+				At     Time   `json:"at"`
+				Data   Binary `json:"data"`
+			}{NewString("<Jane>"), NewInt64(math.MaxInt64), NewInt32(-1), NewInt16(2), NewByte(3), NewTime(date), NewBinary([]byte("hi"))},
+			`{"name":"\u003cJane\u003e","big":9223372036854775807,"Medium":-1,"small":2,"flag":3,"at":"2012-12-12T12:12:12.5Z","data":"aGk="}`,
+		},
+		{
+			"with NULLs",
+			struct {
+				Name String `json:"name"`
+				Big  Int64  `json:"big"`
+				At   Time   `json:"at"`
+				Data Binary `json:"data"`
+			}{String{String: "stale"}, Int64{}, Time{}, Binary{}},
+			`{"name":null,"big":null,"at":null,"data":null}`,
+		},
+		{
+			"with omitnull",
+			struct {
+				Name String `json:"name" nullable:"omitnull"`
+				Big  Int64  `json:"big" nullable:"omitnull"`
+				Ref  *Int64 `json:"ref" nullable:"omitnull"`
+			}{String{}, NewInt64(0), nil},
+			`{"big":0}`,
+		},
+		{
+			"with time options",
+			struct {
+				RFC   Time `json:"rfc" nullable:"rfc3339"`
+				Unix  Time `json:"unix" nullable:"unix"`
+				Milli Time `json:"milli" nullable:"unixmilli"`
+				Null  Time `json:"null" nullable:"unixmilli"`
+			}{NewTime(date), NewTime(date), NewTime(date), Time{}},
+			`{"rfc":"2012-12-12T12:12:12.5Z","unix":1355314332,"milli":1355314332500,"null":null}`,
+		},
+		{
+			"with binary options",
+			struct {
+				Hex    Binary `json:"hex" nullable:"hex"`
+				Base64 Binary `json:"base64" nullable:"base64"`
+				Null   Binary `json:"null" nullable:"hex,omitnull"`
+			}{NewBinary([]byte{0xde, 0xad}), NewBinary([]byte{0xde, 0xad}), Binary{}},
+			`{"hex":"dead","base64":"3q0="}`,
+		},
+		{
+			"with string option",
+			struct {
+				ID   Int64 `json:"id" nullable:"string"`
+				Flag Byte  `json:"flag" nullable:"string"`
+				Null Int64 `json:"null" nullable:"string"`
+			}{NewInt64(math.MaxInt64), NewByte(1), Int64{}},
+			`{"id":"9223372036854775807","flag":"1","null":null}`,
+		},
+		{
+			"with plain fields",
+			struct {
+				Name    string   `json:"name"`
+				Skipped string   `json:"-"`
+				Empty   string   `json:"empty,omitempty"`
+				Tags    []string `json:"tags"`
+				private String
+				Quoted  QuotedInt64 `json:"quoted"`
+				When    time.Time   `json:"when"`
+				Any     any         `json:"any"`
+			}{Name: "x", Skipped: "y", Tags: []string{"a"}, Quoted: NewQuotedInt64(1), When: date, Any: NewString("z")},
+			`{"name":"x","tags":["a"],"quoted":"1","when":"2012-12-12T12:12:12.5Z","any":"z"}`,
+		},
+		{
+			"with nested and embedded structs",
+			struct {
+				Audit
+				*Inner
+				Nested  Audit   `json:"nested"`
+				Pointer *Audit  `json:"pointer"`
+				List    []Audit `json:"list"`
+			}{Audit{NewTime(date)}, &Inner{NewInt32(9)}, Audit{}, &Audit{NewTime(date)}, []Audit{{}, {NewTime(date)}}},
+			`{"created_at":1355314332,"score":9,"nested":{"created_at":null},"pointer":{"created_at":1355314332},"list":[{"created_at":null},{"created_at":1355314332}]}`,
+		},
+		{
+			"with embedded field shadowed by outer field",
+			struct {
+				Named
+				Name String
+			}{Named{NewString("inner")}, NewString("outer")},
+			`{"Name":"outer"}`,
+		},
+		{
+			"with ambiguous embedded fields",
+			struct {
+				Named
+				Other
+				ID Int64
+			}{Named{NewString("a")}, Other{NewString("b")}, NewInt64(1)},
+			`{"ID":1}`,
+		},
+		{
+			"with tagged embedded field breaking a tie",
+			struct {
+				Named
+				Tagged
+			}{Named{NewString("untagged")}, Tagged{NewString("tagged")}},
+			`{"Name":"tagged"}`,
+		},
+		{
+			"with omitzero",
+			struct {
+				Name  Optional[string] `json:"name,omitzero"`
+				Email Optional[string] `json:"email,omitzero"`
+				Age   int              `json:"age,omitzero"`
+				At    time.Time        `json:"at,omitzero"`
+				Ref   *int             `json:"ref,omitzero"`
+				Kept  Int64            `json:"kept,omitzero"`
+			}{Email: NullOptional[string](), Kept: NewInt64(0)},
+			`{"email":null,"kept":0}`,
+		},
+		{
+			"with json string option",
+			struct {
+				N    int     `json:"n,string"`
+				F    float64 `json:"f,string"`
+				S    string  `json:"s,string"`
+				P    *int    `json:"p,string"`
+				Null Int64   `json:"null,string"`
+			}{N: 5, F: 1.5, S: "x"},
+			`{"n":"5","f":"1.5","s":"\"x\"","p":null,"null":null}`,
+		},
+		{
+			"with map values",
+			map[string]struct {
+				S String `json:"s"`
+			}{"b": {NewString("x")}, "a": {String{}}},
+			`{"a":{"s":null},"b":{"s":"x"}}`,
+		},
+		{"with integer map keys", map[int]Int16{2: NewInt16(1), -1: {}}, `{"-1":null,"2":1}`},
+		{"with nil map", map[string]String(nil), `null`},
+		{"with nullable value", NewString("hello"), `"hello"`},
+		{"with nullable slice", []Int16{NewInt16(1), {}}, `[1,null]`},
+		{"with nil", nil, `null`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := MarshalJSON(tc.input)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if string(res) != tc.want {
+				t.Errorf("got: %s, want: %s", res, tc.want)
+				return
+			}
+			if !json.Valid(res) {
+				t.Errorf("invalid JSON: %s", res)
+				return
+			}
+		})
+	}
+}
+
+func TestMarshalJSONTagErrors(t *testing.T) {
+	testCases := []struct {
+		label string
+		input any
+	}{
+		{"with unknown option", struct {
+			A String `nullable:"bogus"`
+		}{}},
+		{"with time option on String", struct {
+			A String `nullable:"unix"`
+		}{}},
+		{"with binary option on Time", struct {
+			A Time `nullable:"hex"`
+		}{}},
+		{"with string option on String", struct {
+			A String `nullable:"string"`
+		}{}},
+		{"with omitnull on plain field", struct {
+			A string `nullable:"omitnull"`
+		}{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if _, err := MarshalJSON(tc.input); err == nil {
+				t.Error("expected an error")
+				return
+			}
+		})
+	}
+}
+
+func TestJSONEncoder(t *testing.T) {
+	var buf bytes.Buffer
+
+	enc := NewJSONEncoder(&buf)
+	for _, v := range []any{NewInt64(1), Int64{}} {
+		if err := enc.Encode(v); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+	}
+
+	if want := "1\nnull\n"; buf.String() != want {
+		t.Errorf("got: %q, want: %q", buf.String(), want)
+		return
+	}
+
+	if err := enc.Encode(struct {
+		A Int64 `nullable:"hex"`
+	}{}); err == nil || !strings.Contains(err.Error(), "field A") {
+		t.Errorf("got: %v, want: an error naming field A", err)
+		return
+	}
+}
+
+func TestMarshalJSONCycle(t *testing.T) {
+	type node struct {
+		Name String `json:"name"`
+		Next *node  `json:"next"`
+	}
+
+	shared := &node{Name: NewString("shared")}
+	if res, err := MarshalJSON([]*node{shared, shared}); err != nil || string(res) != `[{"name":"shared","next":null},{"name":"shared","next":null}]` {
+		t.Errorf("got: %s, %v, want shared pointers encoded twice", res, err)
+		return
+	}
+
+	loop := &node{Name: NewString("a")}
+	loop.Next = &node{Name: NewString("b"), Next: loop}
+
+	cyclic := map[string]any{}
+	cyclic["self"] = cyclic
+
+	type selfEmbedding struct {
+		*selfEmbedding
+		Name String
+	}
+
+	testCases := []struct {
+		label string
+		input any
+	}{
+		{"with pointer cycle", loop},
+		{"with map cycle", cyclic},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var uerr *json.UnsupportedValueError
+			if _, err := MarshalJSON(tc.input); !errors.As(err, &uerr) {
+				t.Errorf("got: %v, want: %T", err, uerr)
+				return
+			}
+		})
+	}
+
+	if res, err := MarshalJSON(selfEmbedding{Name: NewString("x")}); err != nil || string(res) != `{"Name":"x"}` {
+		t.Errorf("got: %s, %v, want: %s", res, err, `{"Name":"x"}`)
+		return
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"sync"
+)
+
+// Key is a comparable representation of a nullable value, meant to be used as
+// a map key when deduplicating or grouping rows. The keys of two values are
+// equal if and only if the values are Equal, so all NULL values share the
+// zero Key regardless of their type or stale payload. Keys of different
+// non-NULL types never collide.
+type Key struct {
+	v any
+}
+
+// The key types below keep payloads of different nullable types apart, for
+// example a String and a Binary holding the same bytes, or an Int64 and a
+// QuotedInt64 holding the same number. The type parameter of encryptedKey
+// keeps the Encrypted instantiations apart too.
+type (
+	stringKey                 string
+	binaryKey                 string
+	quotedInt64Key            int64
+	secretKey                 [sha256.Size]byte
+	encryptedKey[T Plaintext] [sha256.Size]byte
+)
+
+// keyHashKey is the random HMAC key used to derive the keys of sensitive
+// values. It is generated once per process, so that a key can not be
+// matched against a precomputed digest of a guessed value.
+var keyHashKey = sync.OnceValue(func() []byte {
+	key := make([]byte, sha256.Size)
+	rand.Read(key)
+
+	return key
+})
+
+// sensitiveKey returns the keyed digest of a sensitive value.
+func sensitiveKey(b []byte) [sha256.Size]byte {
+	mac := hmac.New(sha256.New, keyHashKey())
+	mac.Write(b)
+
+	return [sha256.Size]byte(mac.Sum(nil))
+}
+
+// Null returns true if the key belongs to a NULL value.
+func (k Key) Null() bool {
+	return k.v == nil
+}
+
+// Key returns the map key of the value.
+func (s String) Key() Key {
+	if !s.Valid {
+		return Key{}
+	}
+
+	return Key{stringKey(s.String)}
+}
+
+// Key returns the map key of the value.
+func (i Int64) Key() Key {
+	if !i.Valid {
+		return Key{}
+	}
+
+	return Key{i.Int64}
+}
+
+// Key returns the map key of the value.
+func (i Int32) Key() Key {
+	if !i.Valid {
+		return Key{}
+	}
+
+	return Key{i.Int32}
+}
+
+// Key returns the map key of the value.
+func (i Int16) Key() Key {
+	if !i.Valid {
+		return Key{}
+	}
+
+	return Key{i.Int16}
+}
+
+// Key returns the map key of the value.
+func (b Byte) Key() Key {
+	if !b.Valid {
+		return Key{}
+	}
+
+	return Key{b.Byte}
+}
+
+// Key returns the map key of the value. Times that represent the same instant
+// share a key regardless of their location and monotonic clock reading.
+func (t Time) Key() Key {
+	if !t.Valid {
+		return Key{}
+	}
+
+	// As documented by the time package, a Time is only safe to compare with
+	// == once its location is fixed and the monotonic reading is stripped.
+	return Key{t.Time.UTC().Round(0)}
+}
+
+// Key returns the map key of the value, which holds a copy of the bytes.
+func (b Binary) Key() Key {
+	if !b.Valid {
+		return Key{}
+	}
+
+	return Key{binaryKey(b.Bytes)}
+}
+
+// Key returns the map key of the value. The key holds a keyed digest of the
+// secret rather than the secret itself.
+func (s Secret) Key() Key {
+	if !s.Valid {
+		return Key{}
+	}
+
+	return Key{secretKey(sensitiveKey(s.bytes()))}
+}
+
+// Key returns the map key of the plaintext. Like the key of a Secret, it
+// holds a keyed digest of the plaintext rather than the plaintext itself.
+func (e Encrypted[T]) Key() Key {
+	if !e.Valid {
+		return Key{}
+	}
+
+	return Key{encryptedKey[T](sensitiveKey([]byte(e.V)))}
+}
+
+// Key returns the map key of the value.
+func (q QuotedInt64) Key() Key {
+	if !q.Valid {
+		return Key{}
+	}
+
+	return Key{quotedInt64Key(q.Int64)}
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestKey(t *testing.T) {
+	now := time.Now()
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	testCases := []struct {
+		label string
+		a, b  Key
+		want  bool
+	}{
+		{"with NULL strings", String{String: "a"}.Key(), String{}.Key(), true},
+		{"with NULL and empty string", String{}.Key(), NewString("").Key(), false},
+		{"with same strings", NewString("a").Key(), NewString("a").Key(), true},
+		{"with different strings", NewString("a").Key(), NewString("b").Key(), false},
+		{"with NULL Int64s", Int64{Int64: 1}.Key(), Int64{}.Key(), true},
+		{"with same Int64s", NewInt64(1).Key(), NewInt64(1).Key(), true},
+		{"with NULL and zero Int64", Int64{}.Key(), NewInt64(0).Key(), false},
+		{"with same Int32s", NewInt32(1).Key(), NewInt32(1).Key(), true},
+		{"with same Int16s", NewInt16(1).Key(), NewInt16(1).Key(), true},
+		{"with NULL Int16s", Int16{Int16: 1}.Key(), Int16{}.Key(), true},
+		{"with Int64 and Int32", NewInt64(1).Key(), NewInt32(1).Key(), false},
+		{"with same Bytes", NewByte(1).Key(), NewByte(1).Key(), true},
+		{"with different Bytes", NewByte(1).Key(), NewByte(2).Key(), false},
+		{"with NULL times", Time{Time: now}.Key(), Time{}.Key(), true},
+		{"with same times", NewTime(now).Key(), NewTime(now).Key(), true},
+		{"with same instant in another location", NewTime(now).Key(), NewTime(now.In(tokyo)).Key(), true},
+		{"with monotonic reading stripped", NewTime(now).Key(), NewTime(now.Round(0)).Key(), true},
+		{"with different times", NewTime(now).Key(), NewTime(now.Add(1)).Key(), false},
+		{"with NULL binaries", Binary{Bytes: []byte("a")}.Key(), Binary{}.Key(), true},
+		{"with same binaries", NewBinary([]byte("a")).Key(), NewBinary([]byte("a")).Key(), true},
+		{"with nil and empty binary", NewBinary(nil).Key(), NewBinary([]byte{}).Key(), true},
+		{"with different binaries", NewBinary([]byte("a")).Key(), NewBinary([]byte("b")).Key(), false},
+		{"with binary and string", NewBinary([]byte("a")).Key(), NewString("a").Key(), false},
+		{"with same secrets", NewSecret([]byte("a")).Key(), NewSecret([]byte("a")).Key(), true},
+		{"with different secrets", NewSecret([]byte("a")).Key(), NewSecret([]byte("b")).Key(), false},
+		{"with same plaintexts", NewEncrypted("a").Key(), NewEncrypted("a").Key(), true},
+		{"with different plaintexts", NewEncrypted("a").Key(), NewEncrypted("b").Key(), false},
+		{"with different plaintext types", NewEncrypted("a").Key(), NewEncrypted([]byte("a")).Key(), false},
+		{"with NULL plaintexts", Encrypted[string]{V: "a"}.Key(), Encrypted[string]{}.Key(), true},
+		{"with NULLs of different types", String{}.Key(), Time{}.Key(), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.a == tc.b; res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestKeyDeduplication(t *testing.T) {
+	rows := []Binary{
+		NewBinary([]byte("a")),
+		{Bytes: []byte("stale")},
+		NewBinary([]byte("a")),
+		{},
+		NewBinary([]byte("b")),
+	}
+
+	seen := make(map[Key]bool)
+	for _, r := range rows {
+		seen[r.Key()] = true
+	}
+
+	if len(seen) != 3 {
+		t.Errorf("got: %v, want: %v", len(seen), 3)
+		return
+	}
+	if !seen[Key{}] || !(Key{}).Null() {
+		t.Error("NULL key not found")
+		return
+	}
+}
+
+func TestKeySensitiveDigest(t *testing.T) {
+	plain := sha256.Sum256([]byte("jane@example.com"))
+
+	testCases := []struct {
+		label string
+		key   Key
+	}{
+		{"with Secret", NewSecret([]byte("jane@example.com")).Key()},
+		{"with Encrypted", NewEncrypted("jane@example.com").Key()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res := fmt.Sprintf("%v", tc.key)
+			if strings.Contains(res, "jane") || strings.Contains(res, fmt.Sprint(plain)) {
+				t.Errorf("key reveals the value: %s", res)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrMergeConflict is returned by MergeStrict when dst and src hold
+// different non-NULL values for the same field.
+var ErrMergeConflict = errors.New("nullable: merge conflict")
+
+var nullableType = reflect.TypeFor[Nullable]()
+
+// Merge fills the NULL fields of the struct pointed to by dst with the
+// corresponding fields of src, which must be a struct of the same type or a
+// pointer to one. Any field that implements Nullable takes part, and a nil
+// pointer or interface counts as NULL. Nested and embedded structs are
+// merged recursively, including those held by pointer when both sides are
+// non-nil. Fields that are non-NULL in dst and fields of other types are left
+// untouched.
+//
+// Fields are filled by plain assignment, so a filled pointer, interface or
+// Binary field of dst shares its memory with the corresponding field of src.
+func Merge(dst, src any) error {
+	_, err := mergeStructs(dst, src, false)
+	return err
+}
+
+// MergeStrict is like Merge, but it refuses to merge if any field is
+// non-NULL on both sides with values that differ according to their Equal
+// method. In that case dst is left unmodified, and the returned error wraps
+// ErrMergeConflict and names the conflicting fields.
+func MergeStrict(dst, src any) error {
+	conflicts, err := mergeStructs(dst, src, true)
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("%w in %s", ErrMergeConflict, strings.Join(conflicts, ", "))
+	}
+
+	return nil
+}
+
+func mergeStructs(dst, src any, strict bool) ([]string, error) {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Pointer || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("nullable: Merge into non-struct pointer %T", dst)
+	}
+	dv = dv.Elem()
+
+	sv, ok := structValue(reflect.ValueOf(src))
+	if !ok || sv.Type() != dv.Type() {
+		return nil, fmt.Errorf("nullable: Merge of %T into %T", src, dst)
+	}
+
+	if strict {
+		var conflicts []string
+		mergeFields(dv, sv, "", &conflicts, false)
+		if len(conflicts) > 0 {
+			return conflicts, nil
+		}
+	}
+	mergeFields(dv, sv, "", nil, true)
+
+	return nil, nil
+}
+
+// mergeFields walks the fields of dst and src, which are structs of the same
+// type, recording the paths of conflicting fields in conflicts if it is
+// non-nil and filling NULL fields of dst if apply is true.
+func mergeFields(dst, src reflect.Value, path string, conflicts *[]string, apply bool) {
+	for i := range dst.NumField() {
+		sf := dst.Type().Field(i)
+		if !sf.IsExported() && !sf.Anonymous {
+			continue
+		}
+		d, s := dst.Field(i), src.Field(i)
+
+		name := path
+		if !sf.Anonymous {
+			name += sf.Name
+		}
+
+		if sf.Type.Implements(nullableType) {
+			if !d.CanSet() || !s.CanInterface() {
+				continue
+			}
+			dn, sn := nullValue(d), nullValue(s)
+
+			switch {
+			case dn && !sn:
+				if apply {
+					d.Set(s)
+				}
+			case !dn && !sn && conflicts != nil && !equalNullables(d, s):
+				*conflicts = append(*conflicts, name)
+			}
+			continue
+		}
+
+		if !sf.Anonymous {
+			name += "."
+		}
+		switch sf.Type.Kind() {
+		case reflect.Struct:
+			mergeFields(d, s, name, conflicts, apply)
+		case reflect.Pointer:
+			if sf.Type.Elem().Kind() == reflect.Struct && !d.IsNil() && !s.IsNil() {
+				mergeFields(d.Elem(), s.Elem(), name, conflicts, apply)
+			}
+		}
+	}
+}
+
+// nullValue reports whether v, which implements Nullable, is NULL. A nil
+// pointer or interface is considered NULL rather than dereferenced.
+func nullValue(v reflect.Value) bool {
+	v, ok := nullableElem(v)
+	if !ok {
+		return true
+	}
+
+	return v.Interface().(Nullable).Null()
+}
+
+// equalNullables reports whether the non-NULL values a and b hold the same
+// type and are equal according to equalValues.
+func equalNullables(a, b reflect.Value) bool {
+	a, _ = nullableElem(a)
+	b, _ = nullableElem(b)
+
+	return a.Type() == b.Type() && equalValues(a, b)
+}
+
+// nullableElem unwraps the pointers and interfaces around the nullable
+// value v, reporting false if it runs into a nil one. A pointer is kept if
+// only the pointer type implements Nullable.
+func nullableElem(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, false
+		}
+		if v.Kind() == reflect.Pointer && !v.Type().Elem().Implements(nullableType) {
+			break
+		}
+		v = v.Elem()
+	}
+
+	return v, true
+}
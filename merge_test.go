@@ -0,0 +1,254 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type mergeAddress struct {
+	City String
+	Zip  Int32
+}
+
+type mergeAudit struct {
+	Updated Time
+}
+
+type mergeRecord struct {
+	mergeAudit
+	Name    String
+	Age     Int64
+	Avatar  Binary
+	Note    string
+	Home    mergeAddress
+	Work    *mergeAddress
+	Nick    Optional[string]
+	private String
+}
+
+func TestMerge(t *testing.T) {
+	now := time.Now()
+
+	dst := mergeRecord{
+		Name:    NewString("Jane"),
+		Home:    mergeAddress{Zip: NewInt32(1000)},
+		Work:    &mergeAddress{},
+		private: String{},
+	}
+	src := mergeRecord{
+		mergeAudit: mergeAudit{Updated: NewTime(now)},
+		Name:       NewString("John"),
+		Age:        NewInt64(30),
+		Avatar:     NewBinary([]byte{}),
+		Note:       "ignored",
+		Home:       mergeAddress{City: NewString("Tokyo"), Zip: NewInt32(2000)},
+		Work:       &mergeAddress{City: NewString("Osaka")},
+		Nick:       NullOptional[string](),
+		private:    NewString("ignored"),
+	}
+	want := mergeRecord{
+		mergeAudit: mergeAudit{Updated: NewTime(now)},
+		Name:       NewString("Jane"),
+		Age:        NewInt64(30),
+		Avatar:     NewBinary([]byte{}),
+		Home:       mergeAddress{City: NewString("Tokyo"), Zip: NewInt32(1000)},
+		Work:       &mergeAddress{City: NewString("Osaka")},
+	}
+
+	if err := Merge(&dst, &src); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("got: %+v, want: %+v", dst, want)
+		return
+	}
+}
+
+func TestMergeStrict(t *testing.T) {
+	testCases := []struct {
+		label string
+		dst   mergeRecord
+		src   mergeRecord
+		err   string
+		want  mergeRecord
+	}{
+		{
+			"without conflict",
+			mergeRecord{Name: NewString("Jane"), Age: Int64{Int64: 1}},
+			mergeRecord{Name: NewString("Jane"), Age: NewInt64(30)},
+			"",
+			mergeRecord{Name: NewString("Jane"), Age: NewInt64(30)},
+		},
+		{
+			"with conflicts",
+			mergeRecord{Name: NewString("Jane"), Home: mergeAddress{City: NewString("Tokyo")}},
+			mergeRecord{Name: NewString("John"), Age: NewInt64(30), Home: mergeAddress{City: NewString("Osaka")}},
+			"nullable: merge conflict in Name, Home.City",
+			mergeRecord{Name: NewString("Jane"), Home: mergeAddress{City: NewString("Tokyo")}},
+		},
+		{
+			"with embedded conflict",
+			mergeRecord{mergeAudit: mergeAudit{Updated: NewTime(time.Unix(1, 0))}},
+			mergeRecord{mergeAudit: mergeAudit{Updated: NewTime(time.Unix(2, 0))}},
+			"nullable: merge conflict in Updated",
+			mergeRecord{mergeAudit: mergeAudit{Updated: NewTime(time.Unix(1, 0))}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			err := MergeStrict(&tc.dst, tc.src)
+			if tc.err == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if tc.err != "" && (!errors.Is(err, ErrMergeConflict) || err.Error() != tc.err) {
+				t.Errorf("got: %v, want: %v", err, tc.err)
+				return
+			}
+			if !reflect.DeepEqual(tc.dst, tc.want) {
+				t.Errorf("got: %+v, want: %+v", tc.dst, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestMergeInvalid(t *testing.T) {
+	var record mergeRecord
+
+	testCases := []struct {
+		label string
+		dst   any
+		src   any
+	}{
+		{"with non-pointer", record, record},
+		{"with nil pointer", (*mergeRecord)(nil), record},
+		{"with mismatched types", &record, mergeAddress{}},
+		{"with non-struct", new(int), 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if err := Merge(tc.dst, tc.src); err == nil {
+				t.Errorf("expected an error")
+				return
+			}
+		})
+	}
+}
+
+func TestMergePointers(t *testing.T) {
+	type row struct {
+		Name *String
+		Age  *Int64
+	}
+
+	name, other := NewString("Jane"), NewString("John")
+	age := NewInt64(30)
+
+	testCases := []struct {
+		label string
+		dst   row
+		src   row
+		want  row
+	}{
+		{"with nil on both sides", row{}, row{}, row{}},
+		{"with nil in dst", row{}, row{Name: &name}, row{Name: &name}},
+		{"with nil in src", row{Name: &name}, row{}, row{Name: &name}},
+		{"with pointer to NULL in dst", row{Age: &Int64{}}, row{Age: &age}, row{Age: &age}},
+		{"with both set", row{Name: &name}, row{Name: &other}, row{Name: &name}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if err := Merge(&tc.dst, &tc.src); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if !reflect.DeepEqual(tc.dst, tc.want) {
+				t.Errorf("got: %+v, want: %+v", tc.dst, tc.want)
+				return
+			}
+		})
+	}
+
+	dst, src := row{Name: &name}, row{Name: &other, Age: &age}
+	if err := MergeStrict(&dst, src); !errors.Is(err, ErrMergeConflict) {
+		t.Errorf("got: %v, want: %v", err, ErrMergeConflict)
+		return
+	}
+	same := NewString("Jane")
+	dst, src = row{Name: &name}, row{Name: &same}
+	if err := MergeStrict(&dst, src); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+}
+
+func TestMergeInterfaces(t *testing.T) {
+	type row struct {
+		X Nullable
+	}
+
+	var nilString *String
+
+	testCases := []struct {
+		label string
+		dst   row
+		src   row
+		want  row
+	}{
+		{"with nil on both sides", row{}, row{}, row{}},
+		{"with nil in dst", row{}, row{X: NewString("a")}, row{X: NewString("a")}},
+		{"with nil in src", row{X: NewString("a")}, row{}, row{X: NewString("a")}},
+		{"with nil pointer in dst", row{X: nilString}, row{X: NewInt64(1)}, row{X: NewInt64(1)}},
+		{"with NULL in dst", row{X: String{}}, row{X: NewString("a")}, row{X: NewString("a")}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if err := Merge(&tc.dst, tc.src); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if !reflect.DeepEqual(tc.dst, tc.want) {
+				t.Errorf("got: %+v, want: %+v", tc.dst, tc.want)
+				return
+			}
+		})
+	}
+
+	dst := row{X: NewString("1")}
+	if err := MergeStrict(&dst, row{X: NewInt64(1)}); !errors.Is(err, ErrMergeConflict) {
+		t.Errorf("got: %v, want: %v", err, ErrMergeConflict)
+		return
+	}
+	if err := MergeStrict(&dst, row{X: NewString("1")}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrAbsent is returned by Optional.Value when the value was never specified.
+// An absent Optional should be left out of the statement altogether; see
+// UpdateColumns.
+var ErrAbsent = errors.New("nullable: optional value is absent")
+
+// Optional is a three-state value that tells a value that was never
+// specified apart from one that was explicitly set to NULL, which is what
+// PATCH-style updates need. Specified reports whether the value was set at
+// all, and Valid reports whether it was set to something other than NULL.
+type Optional[T any] struct {
+	V         T
+	Valid     bool
+	Specified bool
+}
+
+// NewOptional returns an Optional set to the given value.
+func NewOptional[T any](value T) Optional[T] {
+	return Optional[T]{V: value, Valid: true, Specified: true}
+}
+
+// NullOptional returns an Optional explicitly set to NULL.
+func NullOptional[T any]() Optional[T] {
+	return Optional[T]{Specified: true}
+}
+
+// Absent returns true if the value was never specified.
+func (o Optional[T]) Absent() bool {
+	return !o.Specified
+}
+
+// Null returns true if the underlying value is NULL or absent.
+func (o Optional[T]) Null() bool {
+	return !o.Valid
+}
+
+// Nil is an alias for Null() for those that prefer a more Go-like syntax.
+func (o Optional[T]) Nil() bool {
+	return o.Null()
+}
+
+// IsZero reports whether the value is absent, so that absent fields are
+// dropped by the json:",omitzero" option while explicit NULLs are kept.
+func (o Optional[T]) IsZero() bool {
+	return !o.Specified
+}
+
+// Scan implements the Scanner interface. A scanned value is always
+// considered specified.
+func (o *Optional[T]) Scan(value any) error {
+	if value == nil {
+		*o = NullOptional[T]()
+		return nil
+	}
+
+	var v T
+	if s, ok := any(&v).(sql.Scanner); ok {
+		if err := s.Scan(value); err != nil {
+			return err
+		}
+	} else {
+		n := sql.Null[T]{}
+		if err := n.Scan(value); err != nil {
+			return err
+		}
+		v = n.V
+	}
+	*o = NewOptional(v)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. It returns ErrAbsent if the
+// value was never specified.
+func (o Optional[T]) Value() (driver.Value, error) {
+	if !o.Specified {
+		return nil, ErrAbsent
+	}
+	if !o.Valid {
+		return nil, nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(o.V)
+}
+
+// MarshalJSON implements the json.Marshaler interface. Both NULL and absent
+// values encode as null; use the json:",omitzero" option to leave absent
+// values out.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.V)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It is only called
+// for keys that are present in the input, which is how an Optional learns
+// that it was specified.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*o = NullOptional[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = NewOptional(v)
+
+	return nil
+}
+
+func (o Optional[T]) specified() bool {
+	return o.Specified
+}
+
+// optional is implemented by every Optional[T].
+type optional interface {
+	driver.Valuer
+	specified() bool
+}
+
+// UpdateColumns returns the columns and arguments for the Optional fields
+// of the struct v that were specified, in field order, ready to be turned
+// into the SET clause of an UPDATE statement. A column is named by the db
+// struct tag of its field, or by the lowercased field name if it has none.
+// Fields tagged db:"-" and fields that are not an Optional are ignored, and
+// the fields of embedded structs are included as if they were declared by v.
+func UpdateColumns(v any) ([]string, []any, error) {
+	rv, ok := structValue(reflect.ValueOf(v))
+	if !ok {
+		return nil, nil, fmt.Errorf("nullable: UpdateColumns of non-struct %T", v)
+	}
+
+	var columns []string
+	var args []any
+
+	for _, f := range dbFields(rv.Type()) {
+		fv, err := rv.FieldByIndexErr(f.index)
+		if err != nil {
+			continue
+		}
+		if o, ok := fv.Interface().(optional); ok && o.specified() {
+			columns = append(columns, f.column)
+			args = append(args, o)
+		}
+	}
+
+	return columns, args, nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+type patchUser struct {
+	Name  Optional[string] `db:"name" json:"name,omitzero"`
+	Email Optional[string] `db:"email_address" json:"email,omitzero"`
+	Age   Optional[int64]  `json:"age,omitzero"`
+	Note  string           `db:"note" json:"-"`
+}
+
+func TestOptionalUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		label string
+		input string
+		want  patchUser
+	}{
+		{"with nothing", `{}`, patchUser{}},
+		{"with null", `{"name":null}`, patchUser{Name: NullOptional[string]()}},
+		{"with value", `{"name":"Jane","age":30}`, patchUser{Name: NewOptional("Jane"), Age: NewOptional[int64](30)}},
+		{"with mixture", `{"email":null,"age":0}`, patchUser{Email: NullOptional[string](), Age: NewOptional[int64](0)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var res patchUser
+			if err := json.Unmarshal([]byte(tc.input), &res); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res != tc.want {
+				t.Errorf("got: %+v, want: %+v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestOptionalMarshalJSON(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject patchUser
+		want    string
+	}{
+		{"with absent", patchUser{}, `{}`},
+		{"with null", patchUser{Name: NullOptional[string]()}, `{"name":null}`},
+		{"with value", patchUser{Name: NewOptional("Jane"), Age: NewOptional[int64](0)}, `{"name":"Jane","age":0}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := json.Marshal(tc.subject)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if string(res) != tc.want {
+				t.Errorf("got: %s, want: %s", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestOptionalValue(t *testing.T) {
+	if _, err := (Optional[string]{}).Value(); !errors.Is(err, ErrAbsent) {
+		t.Errorf("got: %v, want: %v", err, ErrAbsent)
+		return
+	}
+
+	testCases := []struct {
+		label   string
+		subject Optional[int32]
+		want    any
+	}{
+		{"with NULL", NullOptional[int32](), nil},
+		{"with value", NewOptional[int32](7), int64(7)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := tc.subject.Value()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestOptionalScan(t *testing.T) {
+	testCases := []struct {
+		label string
+		input any
+		want  Optional[string]
+	}{
+		{"with NULL", nil, NullOptional[string]()},
+		{"with string", "hello", NewOptional("hello")},
+		{"with bytes", []byte("hello"), NewOptional("hello")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var res Optional[string]
+			if err := res.Scan(tc.input); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res != tc.want {
+				t.Errorf("got: %+v, want: %+v", res, tc.want)
+				return
+			}
+		})
+	}
+
+	var res Optional[String]
+	if err := res.Scan("hello"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !res.Valid || !res.V.Equal(NewString("hello")) {
+		t.Errorf("got: %+v, want: %v", res, "hello")
+		return
+	}
+}
+
+func TestUpdateColumns(t *testing.T) {
+	type audited struct {
+		patchUser
+		Updated Optional[int64] `db:"updated_at"`
+	}
+
+	testCases := []struct {
+		label       string
+		subject     any
+		wantColumns []string
+		wantArgs    []any
+	}{
+		{"with nothing specified", patchUser{Note: "ignored"}, nil, nil},
+		{"with null", &patchUser{Email: NullOptional[string]()}, []string{"email_address"}, []any{nil}},
+		{
+			"with values",
+			patchUser{Name: NewOptional("Jane"), Age: NewOptional[int64](30)},
+			[]string{"name", "age"},
+			[]any{"Jane", int64(30)},
+		},
+		{
+			"with embedded struct",
+			audited{patchUser: patchUser{Name: NewOptional("Jane")}, Updated: NewOptional[int64](1)},
+			[]string{"name", "updated_at"},
+			[]any{"Jane", int64(1)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			columns, args, err := UpdateColumns(tc.subject)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if !slices.Equal(columns, tc.wantColumns) {
+				t.Errorf("got: %v, want: %v", columns, tc.wantColumns)
+				return
+			}
+
+			var values []any
+			for _, arg := range args {
+				v, err := arg.(optional).Value()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				values = append(values, v)
+			}
+			if !slices.Equal(values, tc.wantArgs) {
+				t.Errorf("got: %v, want: %v", values, tc.wantArgs)
+				return
+			}
+		})
+	}
+
+	if _, _, err := UpdateColumns("not a struct"); err == nil {
+		t.Errorf("expected an error for a non-struct")
+		return
+	}
+}
+
+func TestUpdateColumnsSelfEmbedding(t *testing.T) {
+	type node struct {
+		*node
+		Name Optional[string] `db:"name"`
+	}
+
+	columns, _, err := UpdateColumns(node{Name: NewOptional("x")})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if want := []string{"name"}; !slices.Equal(columns, want) {
+		t.Errorf("got: %v, want: %v", columns, want)
+		return
+	}
+}
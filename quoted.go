@@ -0,0 +1,107 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// QuotedInt64 is an Int64 that marshals to JSON and GraphQL as a quoted
+// decimal string, so that values beyond 2^53 survive JavaScript clients that
+// parse numbers as doubles, and values beyond 32 bits survive the GraphQL Int
+// scalar. Both quoted and unquoted numbers are accepted when decoding,
+// and NULL round-trips as null. Convert to and from Int64 with a plain type
+// conversion.
+type QuotedInt64 Int64
+
+// NewQuotedInt64 returns a QuotedInt64 populated with the given int64.
+func NewQuotedInt64(value int64) QuotedInt64 {
+	return QuotedInt64{Int64: value, Valid: true}
+}
+
+// Scan wraps the Int64 Scan function, which implements the Scanner interface.
+func (q *QuotedInt64) Scan(value any) error {
+	return (*Int64)(q).Scan(value)
+}
+
+// Value wraps the Int64 Value function, which implements the driver Valuer
+// interface.
+func (q QuotedInt64) Value() (driver.Value, error) {
+	return Int64(q).Value()
+}
+
+// Null returns true if the underlying value is NULL.
+func (q QuotedInt64) Null() bool {
+	return !q.Valid
+}
+
+// Nil is an alias for Null() for those that prefer a more Go-like syntax.
+func (q QuotedInt64) Nil() bool {
+	return q.Null()
+}
+
+// Equal reports whether q and other hold the same value, like Int64.Equal.
+func (q QuotedInt64) Equal(other QuotedInt64) bool {
+	return Int64(q).Equal(Int64(other))
+}
+
+// Normalize zeroes the payload of a NULL value, so that it compares equal to
+// any other NULL value with ==.
+func (q *QuotedInt64) Normalize() {
+	(*Int64)(q).Normalize()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q QuotedInt64) MarshalJSON() ([]byte, error) {
+	if !q.Valid {
+		return []byte("null"), nil
+	}
+
+	b := append([]byte{'"'}, strconv.FormatInt(q.Int64, 10)...)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (q *QuotedInt64) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*q = QuotedInt64{}
+		return nil
+	}
+
+	text := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+	}
+
+	v, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return fmt.Errorf("cannot unmarshal %s into int64: %w", data, err)
+	}
+	*q = NewQuotedInt64(v)
+
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestQuotedInt64MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject QuotedInt64
+		want    string
+	}{
+		{"with NULL", QuotedInt64{Int64: 1}, "null"},
+		{"with zero", NewQuotedInt64(0), `"0"`},
+		{"with maximum", NewQuotedInt64(math.MaxInt64), `"9223372036854775807"`},
+		{"with minimum", NewQuotedInt64(math.MinInt64), `"-9223372036854775808"`},
+		{"with converted Int64", QuotedInt64(NewInt64(1 << 53)), `"9007199254740992"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := json.Marshal(tc.subject)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if string(res) != tc.want {
+				t.Errorf("got: %s, want: %s", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestQuotedInt64UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		label   string
+		input   string
+		want    QuotedInt64
+		wantErr bool
+	}{
+		{"with null", `null`, QuotedInt64{}, false},
+		{"with quoted number", `"42"`, NewQuotedInt64(42), false},
+		{"with unquoted number", `42`, NewQuotedInt64(42), false},
+		{"with quoted maximum", `"9223372036854775807"`, NewQuotedInt64(math.MaxInt64), false},
+		{"with unquoted maximum", `9223372036854775807`, NewQuotedInt64(math.MaxInt64), false},
+		{"with quoted minimum", `"-9223372036854775808"`, NewQuotedInt64(math.MinInt64), false},
+		{"with unquoted minimum", `-9223372036854775808`, NewQuotedInt64(math.MinInt64), false},
+		{"with quoted overflow", `"9223372036854775808"`, QuotedInt64{}, true},
+		{"with unquoted overflow", `9223372036854775808`, QuotedInt64{}, true},
+		{"with fraction", `1.5`, QuotedInt64{}, true},
+		{"with exponent", `1e3`, QuotedInt64{}, true},
+		{"with quoted null", `"null"`, QuotedInt64{}, true},
+		{"with empty string", `""`, QuotedInt64{}, true},
+		{"with boolean", `true`, QuotedInt64{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res := NewQuotedInt64(7)
+
+			err := json.Unmarshal([]byte(tc.input), &res)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if !tc.wantErr && res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestQuotedInt64RoundTrip(t *testing.T) {
+	type row struct {
+		ID     QuotedInt64 `json:"id"`
+		Parent QuotedInt64 `json:"parent"`
+	}
+
+	src := row{ID: NewQuotedInt64(math.MaxInt64)}
+
+	b, err := json.Marshal(src)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if want := `{"id":"9223372036854775807","parent":null}`; string(b) != want {
+		t.Errorf("got: %s, want: %s", b, want)
+		return
+	}
+
+	var res row
+	if err := json.Unmarshal(b, &res); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if res != src {
+		t.Errorf("got: %v, want: %v", res, src)
+		return
+	}
+}
+
+func TestQuotedInt64SQL(t *testing.T) {
+	var q QuotedInt64
+
+	if err := q.Scan(int64(math.MaxInt64)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if q != NewQuotedInt64(math.MaxInt64) || q.Null() || q.Nil() {
+		t.Errorf("got: %v, want: %v", q, int64(math.MaxInt64))
+		return
+	}
+
+	if v, err := (QuotedInt64{}).Value(); v != nil || err != nil {
+		t.Errorf("got: %v, %v, want: %v, %v", v, err, nil, nil)
+		return
+	}
+	if err := q.Scan(nil); err != nil || !q.Null() {
+		t.Errorf("got: %v, %v, want: NULL", q, err)
+		return
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"context"
+	"database/sql"
+	"iter"
+	"reflect"
+)
+
+// Queryer is implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// Rows runs query against db and returns an iterator over its rows, each
+// scanned into a T. If T is a struct with db-mapped fields, columns are
+// matched to fields as described for ScanStruct. Otherwise, such as for a
+// String or an int64, the query must return a single column that is scanned
+// into T directly.
+//
+// Errors from the query, from scanning and from rows.Err are yielded along
+// with a zero T, after which iteration stops. The rows are closed when
+// iteration ends, including when the loop body breaks out early.
+func Rows[T any](ctx context.Context, db Queryer, query string, args ...any) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		rows, err := db.QueryContext(ctx, query, args...)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+
+		scan, err := rowScanner[T](rows)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		for rows.Next() {
+			var v T
+			if err := scan(&v); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+// rowScanner returns a function that scans the current row of rows into a
+// T, either field by field or as a single column.
+func rowScanner[T any](rows *sql.Rows) (func(*T) error, error) {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct || reflect.PointerTo(t).Implements(scannerType) || len(dbFields(t)) == 0 {
+		return func(v *T) error {
+			return rows.Scan(v)
+		}, nil
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	plan, err := scanPlan(t, columns, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(v *T) error {
+		return scanRow(rows, reflect.ValueOf(v).Elem(), plan)
+	}, nil
+}
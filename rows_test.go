@@ -0,0 +1,144 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRows(t *testing.T) {
+	results := scanFlavorResults()
+	results["names"] = fakeResult{
+		columns: []string{"flavor"},
+		rows:    [][]driver.Value{{"vanilla"}, {nil}, {"mint"}},
+	}
+	results["times"] = fakeResult{
+		columns: []string{"created_at"},
+		rows:    [][]driver.Value{{time.Unix(0, 0).UTC()}},
+	}
+	db := openFakeDB(t, results)
+	ctx := context.Background()
+
+	var flavors []scanFlavor
+	for v, err := range Rows[scanFlavor](ctx, db, "flavors") {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		flavors = append(flavors, v)
+	}
+	if len(flavors) != 2 || flavors[0].Flavor != NewString("vanilla") || !flavors[1].Flavor.Null() {
+		t.Errorf("got: %+v, want: vanilla and NULL", flavors)
+		return
+	}
+
+	var names []String
+	for v, err := range Rows[String](ctx, db, "names") {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		names = append(names, v)
+	}
+	if want := []String{NewString("vanilla"), {}, NewString("mint")}; !slices.Equal(names, want) {
+		t.Errorf("got: %v, want: %v", names, want)
+		return
+	}
+
+	for v, err := range Rows[time.Time](ctx, db, "times") {
+		if err != nil || !v.Equal(time.Unix(0, 0)) {
+			t.Errorf("got: %v, %v, want: %v", v, err, time.Unix(0, 0))
+			return
+		}
+	}
+
+	if fakeOpenRows.Load() != 0 {
+		t.Errorf("got: %v open rows, want: %v", fakeOpenRows.Load(), 0)
+		return
+	}
+}
+
+func TestRowsBreak(t *testing.T) {
+	db := openFakeDB(t, scanFlavorResults())
+
+	for v, err := range Rows[scanFlavor](context.Background(), db, "flavors") {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if v.ID == 1 {
+			break
+		}
+	}
+
+	if fakeOpenRows.Load() != 0 {
+		t.Errorf("got: %v open rows, want: %v", fakeOpenRows.Load(), 0)
+		return
+	}
+}
+
+func TestRowsError(t *testing.T) {
+	db := openFakeDB(t, scanFlavorResults())
+	ctx := context.Background()
+
+	testCases := []struct {
+		label string
+		query string
+		rows  int
+		want  error
+	}{
+		{"with rows error", "broken", 1, errFakeRows},
+		{"with query error", "unknown", 0, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var rows, errs int
+			var res error
+			for _, err := range Rows[scanFlavor](ctx, db, tc.query) {
+				if err != nil {
+					errs++
+					res = err
+					continue
+				}
+				rows++
+			}
+			if rows != tc.rows || errs != 1 {
+				t.Errorf("got: %v rows and %v errors, want: %v rows and 1 error", rows, errs, tc.rows)
+				return
+			}
+			if tc.want != nil && !errors.Is(res, tc.want) {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+
+	if fakeOpenRows.Load() != 0 {
+		t.Errorf("got: %v open rows, want: %v", fakeOpenRows.Load(), 0)
+		return
+	}
+}
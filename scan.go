@@ -0,0 +1,196 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// dbColumnCache maps a struct type to its *dbColumnIndex.
+var dbColumnCache sync.Map
+
+// dbColumnIndex maps columns to field indexes, both as named and lowercased.
+type dbColumnIndex struct {
+	exact  map[string][]int
+	folded map[string][]int
+}
+
+// dbColumns returns the column index of the struct type t.
+func dbColumns(t reflect.Type) *dbColumnIndex {
+	if columns, ok := dbColumnCache.Load(t); ok {
+		return columns.(*dbColumnIndex)
+	}
+
+	columns := &dbColumnIndex{exact: make(map[string][]int), folded: make(map[string][]int)}
+	for _, f := range dbFields(t) {
+		columns.exact[f.column] = f.index
+
+		// Should several columns fold to the same name, the first one wins.
+		folded := strings.ToLower(f.column)
+		if _, ok := columns.folded[folded]; !ok {
+			columns.folded[folded] = f.index
+		}
+	}
+
+	actual, _ := dbColumnCache.LoadOrStore(t, columns)
+	return actual.(*dbColumnIndex)
+}
+
+// scanPlan returns the field index for each of the result columns when
+// scanning into the struct type t, with nil for columns that do not map to
+// a field. Columns are matched exactly first and then case-insensitively,
+// and in strict mode an unmapped column is an error.
+func scanPlan(t reflect.Type, columns []string, strict bool) ([][]int, error) {
+	fields := dbColumns(t)
+	plan := make([][]int, len(columns))
+
+	for i, column := range columns {
+		index, ok := fields.exact[column]
+		if !ok {
+			index, ok = fields.folded[strings.ToLower(column)]
+		}
+		if !ok && strict {
+			return nil, fmt.Errorf("nullable: column %q has no matching field in %v", column, t)
+		}
+		plan[i] = index
+	}
+
+	return plan, nil
+}
+
+// discard is a scan destination for columns that do not map to a field.
+type discard struct{}
+
+// Scan implements the Scanner interface.
+func (discard) Scan(any) error {
+	return nil
+}
+
+// scanRow scans the current row into the addressable struct v according to
+// plan, allocating nil embedded pointers on the way to a mapped field.
+func scanRow(rows *sql.Rows, v reflect.Value, plan [][]int) error {
+	dest := make([]any, len(plan))
+
+	for i, index := range plan {
+		if index == nil {
+			dest[i] = discard{}
+			continue
+		}
+		fv := v
+		for _, j := range index {
+			if fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					if !fv.CanSet() {
+						return fmt.Errorf("nullable: cannot allocate embedded pointer to unexported %v", fv.Type().Elem())
+					}
+					fv.Set(reflect.New(fv.Type().Elem()))
+				}
+				fv = fv.Elem()
+			}
+			fv = fv.Field(j)
+		}
+		dest[i] = fv.Addr().Interface()
+	}
+
+	return rows.Scan(dest...)
+}
+
+// ScanStruct scans the current row of rows into the struct pointed to by
+// dst. Each column is stored in the field whose db struct tag names it, or
+// whose lowercased name matches it if the field has no tag, and the fields
+// of embedded structs are matched as if they were declared by dst. Fields
+// may be of any type that rows.Scan accepts, nullable or not. Columns that
+// do not match a field are skipped.
+func ScanStruct(rows *sql.Rows, dst any) error {
+	return scanStruct(rows, dst, false)
+}
+
+// ScanStructStrict is like ScanStruct, but returns an error if a column does
+// not match a field.
+func ScanStructStrict(rows *sql.Rows, dst any) error {
+	return scanStruct(rows, dst, true)
+}
+
+func scanStruct(rows *sql.Rows, dst any, strict bool) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("nullable: ScanStruct into non-struct pointer %T", dst)
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	plan, err := scanPlan(v.Elem().Type(), columns, strict)
+	if err != nil {
+		return err
+	}
+
+	return scanRow(rows, v.Elem(), plan)
+}
+
+// ScanAll scans every remaining row of rows into a struct of type T as
+// described for ScanStruct, and closes rows when done.
+func ScanAll[T any](rows *sql.Rows) ([]T, error) {
+	return scanAll[T](rows, false)
+}
+
+// ScanAllStrict is like ScanAll, but returns an error if a column does not
+// match a field.
+func ScanAllStrict[T any](rows *sql.Rows) ([]T, error) {
+	return scanAll[T](rows, true)
+}
+
+func scanAll[T any](rows *sql.Rows, strict bool) ([]T, error) {
+	defer rows.Close()
+
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("nullable: ScanAll into non-struct %v", t)
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	plan, err := scanPlan(t, columns, strict)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []T
+	for rows.Next() {
+		var v T
+		if err := scanRow(rows, reflect.ValueOf(&v).Elem(), plan); err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type scanTimestamps struct {
+	Created Time `db:"created_at"`
+}
+
+// ScanMeta is exported so that ScanStruct can allocate it when embedded by
+// pointer.
+type ScanMeta struct {
+	Note string `db:"note"`
+}
+
+type scanFlavor struct {
+	*ScanMeta
+	scanTimestamps
+	ID      int64  `db:"id"`
+	Flavor  String `db:"flavor"`
+	Rating  Int16
+	Label   *string `db:"label"`
+	Ignored String  `db:"-"`
+}
+
+var errFakeRows = errors.New("fake: connection reset")
+
+func scanFlavorResults() map[string]fakeResult {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	return map[string]fakeResult{
+		"flavors": {
+			columns: []string{"id", "flavor", "RATING", "created_at", "label", "note"},
+			rows: [][]driver.Value{
+				{int64(1), "vanilla", int64(5), created, "v", "first"},
+				{int64(2), nil, nil, nil, nil, "second"},
+			},
+		},
+		"extra": {
+			columns: []string{"id", "popularity"},
+			rows:    [][]driver.Value{{int64(1), int64(99)}},
+		},
+		"folded": {
+			columns: []string{"userid", "FLAVOR"},
+			rows:    [][]driver.Value{{int64(7), "mint"}},
+		},
+		"broken": {
+			columns: []string{"id"},
+			rows:    [][]driver.Value{{int64(1)}},
+			err:     errFakeRows,
+		},
+	}
+}
+
+func TestScanStruct(t *testing.T) {
+	db := openFakeDB(t, scanFlavorResults())
+	rows := query(t, db, "flavors")
+	defer rows.Close()
+
+	if !rows.Next() {
+		t.Fatalf("expected a row: %v", rows.Err())
+	}
+
+	var res scanFlavor
+	if err := ScanStruct(rows, &res); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	label := "v"
+	want := scanFlavor{
+		ScanMeta:       &ScanMeta{Note: "first"},
+		scanTimestamps: scanTimestamps{Created: NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))},
+		ID:             1,
+		Flavor:         NewString("vanilla"),
+		Rating:         NewInt16(5),
+		Label:          &label,
+	}
+	if !reflect.DeepEqual(res, want) {
+		t.Errorf("got: %+v, want: %+v", res, want)
+		return
+	}
+
+	if err := ScanStruct(rows, res); err == nil {
+		t.Errorf("expected an error for a non-pointer")
+		return
+	}
+}
+
+func TestScanAll(t *testing.T) {
+	db := openFakeDB(t, scanFlavorResults())
+
+	res, err := ScanAll[scanFlavor](query(t, db, "flavors"))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if len(res) != 2 {
+		t.Errorf("got: %v, want: %v", len(res), 2)
+		return
+	}
+
+	second := res[1]
+	if second.ID != 2 || !second.Flavor.Null() || !second.Rating.Null() || !second.Created.Null() || second.Label != nil {
+		t.Errorf("got: %+v, want NULL fields", second)
+		return
+	}
+	if fakeOpenRows.Load() != 0 {
+		t.Errorf("got: %v open rows, want: %v", fakeOpenRows.Load(), 0)
+		return
+	}
+
+	res, err = ScanAll[scanFlavor](query(t, db, "extra"))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if len(res) != 1 || res[0].ID != 1 {
+		t.Errorf("got: %+v, want: one row with ID 1", res)
+		return
+	}
+
+	if _, err := ScanAll[scanFlavor](query(t, db, "broken")); !errors.Is(err, errFakeRows) {
+		t.Errorf("got: %v, want: %v", err, errFakeRows)
+		return
+	}
+	if _, err := ScanAll[int64](query(t, db, "extra")); err == nil {
+		t.Errorf("expected an error for a non-struct")
+		return
+	}
+}
+
+func TestScanAllStrict(t *testing.T) {
+	db := openFakeDB(t, scanFlavorResults())
+
+	if _, err := ScanAllStrict[scanFlavor](query(t, db, "flavors")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	want := `nullable: column "popularity" has no matching field in nullable.scanFlavor`
+	if _, err := ScanAllStrict[scanFlavor](query(t, db, "extra")); err == nil || err.Error() != want {
+		t.Errorf("got: %v, want: %v", err, want)
+		return
+	}
+}
+
+func TestScanAllCaseInsensitive(t *testing.T) {
+	type account struct {
+		UserID int64  `db:"UserID"`
+		Flavor String `db:"flavor"`
+	}
+
+	db := openFakeDB(t, scanFlavorResults())
+
+	res, err := ScanAllStrict[account](query(t, db, "folded"))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if want := []account{{UserID: 7, Flavor: NewString("mint")}}; !reflect.DeepEqual(res, want) {
+		t.Errorf("got: %+v, want: %+v", res, want)
+		return
+	}
+}
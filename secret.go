@@ -0,0 +1,183 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+// redacted replaces the value of a Secret wherever it would be printed.
+const redacted = "[REDACTED]"
+
+// Secret holds a nullable sensitive value such as a token or an email
+// address. It scans and stores like String or Binary, but fmt, JSON, text
+// marshaling and slog all print [REDACTED] in place of the value, which is
+// only available through Reveal().
+type Secret struct {
+	p     *secretBuf
+	Valid bool
+}
+
+// secretBuf holds the bytes of a Secret two pointers away, so that fmt
+// prints only an address when it formats a Secret without calling its
+// methods, as it does for unexported struct fields, even when the verb does
+// not apply and fmt falls back to dereferencing the outer pointer.
+type secretBuf struct {
+	b *[]byte
+}
+
+// newSecretBuf returns a secretBuf holding b.
+func newSecretBuf(b []byte) *secretBuf {
+	return &secretBuf{b: &b}
+}
+
+// bytes returns the underlying bytes, or nil if there are none.
+func (s Secret) bytes() []byte {
+	if s.p == nil {
+		return nil
+	}
+
+	return *s.p.b
+}
+
+// NewSecret returns a Secret populated with the given bytes. The Secret takes
+// ownership of value, so Clear() zeroes the caller's slice too.
+func NewSecret(value []byte) Secret {
+	return Secret{p: newSecretBuf(value), Valid: true}
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *Secret) Scan(value any) error {
+	if value == nil {
+		s.Clear()
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		s.Clear()
+		s.p = newSecretBuf(bytes.Clone(v))
+		s.Valid = true
+
+		return nil
+	case string:
+		s.Clear()
+		s.p = newSecretBuf([]byte(v))
+		s.Valid = true
+
+		return nil
+	}
+
+	return fmt.Errorf("cannot scan type %T into secret", value)
+}
+
+// Value implements the driver.Valuer interface. The value is handed to the
+// driver as a byte slice.
+func (s Secret) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+
+	return s.bytes(), nil
+}
+
+// Reveal returns the underlying bytes, or nil if the value is NULL. The slice
+// is not a copy and is zeroed by Clear().
+func (s Secret) Reveal() []byte {
+	if !s.Valid {
+		return nil
+	}
+
+	return s.bytes()
+}
+
+// Clear zeroes the underlying bytes and sets the value to NULL.
+func (s *Secret) Clear() {
+	clear(s.bytes())
+	s.p, s.Valid = nil, false
+}
+
+// Present returns true if the value is non-empty.
+func (s Secret) Present() bool {
+	return s.Valid && len(s.bytes()) > 0
+}
+
+// Null returns true if the underlying value is NULL.
+func (s Secret) Null() bool {
+	return !s.Valid
+}
+
+// Nil is an alias for Null() for those who prefer a more Go-like syntax.
+func (s Secret) Nil() bool {
+	return s.Null()
+}
+
+// Equal reports whether s and other hold the same bytes, comparing them in
+// constant time. All NULL values are equal to each other, and NULL is never
+// equal to a non-NULL value.
+func (s Secret) Equal(other Secret) bool {
+	if !s.Valid || !other.Valid {
+		return s.Valid == other.Valid
+	}
+
+	return subtle.ConstantTimeCompare(s.bytes(), other.bytes()) == 1
+}
+
+// Normalize zeroes and drops the payload of a NULL value.
+func (s *Secret) Normalize() {
+	if !s.Valid {
+		s.Clear()
+	}
+}
+
+// String implements the fmt.Stringer interface and always returns [REDACTED].
+func (s Secret) String() string {
+	return redacted
+}
+
+// Format implements the fmt.Formatter interface and prints [REDACTED] for
+// every verb, including %+v and %#v.
+func (s Secret) Format(f fmt.State, verb rune) {
+	io.WriteString(f, redacted)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface and always
+// returns [REDACTED].
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(redacted), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface and always returns the
+// JSON string "[REDACTED]".
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + redacted + `"`), nil
+}
+
+// LogValue implements the slog.LogValuer interface and always returns
+// [REDACTED].
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
@@ -0,0 +1,261 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSecretScan(t *testing.T) {
+	testCases := []struct {
+		label   string
+		input   any
+		wantErr bool
+		wantVal bool
+		want    string
+	}{
+		{"with nil", nil, false, false, ""},
+		{"with string", "hunter2", false, true, "hunter2"},
+		{"with bytes", []byte("hunter2"), false, true, "hunter2"},
+		{"with invalid type", 42, true, false, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var s Secret
+
+			err := s.Scan(tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if s.Valid != tc.wantVal {
+				t.Errorf("got: %v, want: %v", s.Valid, tc.wantVal)
+				return
+			}
+			if string(s.Reveal()) != tc.want {
+				t.Errorf("got: %v, want: %v", string(s.Reveal()), tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestSecretScanCopiesBytes(t *testing.T) {
+	var s Secret
+
+	src := []byte("hunter2")
+	if err := s.Scan(src); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	src[0] = 'x'
+
+	if s.Reveal()[0] == 'x' {
+		t.Error("original bytes still referenced")
+		return
+	}
+}
+
+func TestSecretValue(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Secret
+		want    any
+	}{
+		{"with NULL secret", Secret{}, nil},
+		{"with non-empty secret", NewSecret([]byte("hunter2")), "hunter2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := tc.subject.Value()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if tc.want == nil {
+				if res != nil {
+					t.Errorf("got: %v, want: %v", res, nil)
+				}
+				return
+			}
+			if string(res.([]byte)) != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestSecretState(t *testing.T) {
+	testCases := []struct {
+		label       string
+		subject     Secret
+		wantNull    bool
+		wantPresent bool
+	}{
+		{"with NULL secret", Secret{}, true, false},
+		{"with empty secret", NewSecret([]byte{}), false, false},
+		{"with non-empty secret", NewSecret([]byte("hunter2")), false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.Null(); res != tc.wantNull {
+				t.Errorf("got: %v, want: %v", res, tc.wantNull)
+				return
+			}
+			if res := tc.subject.Nil(); res != tc.wantNull {
+				t.Errorf("got: %v, want: %v", res, tc.wantNull)
+				return
+			}
+			if res := tc.subject.Present(); res != tc.wantPresent {
+				t.Errorf("got: %v, want: %v", res, tc.wantPresent)
+				return
+			}
+		})
+	}
+}
+
+func TestSecretClear(t *testing.T) {
+	src := []byte("hunter2")
+	s := NewSecret(src)
+
+	s.Clear()
+
+	if !s.Null() || s.Reveal() != nil {
+		t.Errorf("got: %v, want: NULL", s.Reveal())
+		return
+	}
+	if !bytes.Equal(src, make([]byte, len(src))) {
+		t.Errorf("got: %v, want: zeroed bytes", src)
+		return
+	}
+}
+
+func TestSecretRedaction(t *testing.T) {
+	subject := struct {
+		Token Secret
+		Email *Secret
+	}{
+		NewSecret([]byte("hunter2")),
+		&Secret{p: newSecretBuf([]byte("jane@example.com")), Valid: true},
+	}
+
+	var logs bytes.Buffer
+	slog.New(slog.NewTextHandler(&logs, nil)).Info("login", "token", subject.Token)
+
+	jsonOut, err := json.Marshal(subject)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	textOut, err := subject.Token.MarshalText()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	outputs := map[string]string{
+		"%v":   fmt.Sprintf("%v", subject),
+		"%+v":  fmt.Sprintf("%+v", subject),
+		"%#v":  fmt.Sprintf("%#v", subject.Token),
+		"%s":   fmt.Sprintf("%s", subject.Token),
+		"%q":   fmt.Sprintf("%q", subject.Token),
+		"%x":   fmt.Sprintf("%x", subject.Token),
+		"json": string(jsonOut),
+		"text": string(textOut),
+		"slog": logs.String(),
+	}
+
+	for label, out := range outputs {
+		t.Run(label, func(t *testing.T) {
+			if strings.Contains(out, "hunter2") || strings.Contains(out, "jane") {
+				t.Errorf("secret leaked: %s", out)
+				return
+			}
+			if !strings.Contains(out, "[REDACTED]") {
+				t.Errorf("got: %s, want: [REDACTED]", out)
+				return
+			}
+		})
+	}
+}
+
+func TestSecretUnexportedField(t *testing.T) {
+	type user struct {
+		token Secret
+	}
+
+	subject := user{token: NewSecret([]byte("hunter2"))}
+
+	for _, verb := range []string{"%v", "%+v", "%#v", "%s", "%x"} {
+		t.Run(verb, func(t *testing.T) {
+			out := fmt.Sprintf(verb, subject)
+			if strings.Contains(out, "hunter2") || strings.Contains(out, "104 117 110") || strings.Contains(out, "68756e74657232") {
+				t.Errorf("secret leaked: %s", out)
+				return
+			}
+		})
+	}
+}
+
+func TestSecretEqual(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Secret
+		other   Secret
+		want    bool
+	}{
+		{"with both NULL", Secret{}, Secret{p: newSecretBuf([]byte("stale"))}, true},
+		{"with NULL and empty secret", Secret{}, NewSecret([]byte{}), false},
+		{"with same secrets", NewSecret([]byte("a")), NewSecret([]byte("a")), true},
+		{"with different secrets", NewSecret([]byte("a")), NewSecret([]byte("b")), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.Equal(tc.other); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestSecretNormalize(t *testing.T) {
+	src := []byte("stale")
+	s := Secret{p: newSecretBuf(src)}
+
+	s.Normalize()
+
+	if s.p != nil || !bytes.Equal(src, make([]byte, len(src))) {
+		t.Errorf("got: %v, want: zeroed bytes", src)
+		return
+	}
+}
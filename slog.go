@@ -0,0 +1,142 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import "log/slog"
+
+// nullLogValue is the slog.Value logged in place of NULL, which the JSON
+// handler prints as null. Use ReplaceNullAttr to log it differently with a
+// given handler.
+var nullLogValue = slog.AnyValue(nil)
+
+// ReplaceNullAttr returns a function for the ReplaceAttr field of
+// slog.HandlerOptions that logs null in place of NULL values, for example
+// slog.StringValue("NULL"). Since the function sees values after LogValue
+// has been resolved, it applies to any nil value.
+func ReplaceNullAttr(null slog.Value) func(groups []string, a slog.Attr) slog.Attr {
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if a.Value.Kind() == slog.KindAny && a.Value.Any() == nil {
+			a.Value = null
+		}
+
+		return a
+	}
+}
+
+// BinaryLength is a Binary that logs its length as an int rather than its
+// contents as a hexadecimal string, which keeps large or sensitive payloads
+// out of the logs. Convert a Binary to it at the logging call site, as in
+// slog.Info("upload", "body", nullable.BinaryLength(body)).
+type BinaryLength Binary
+
+// LogValue implements the slog.LogValuer interface.
+func (b BinaryLength) LogValue() slog.Value {
+	if !b.Valid {
+		return nullLogValue
+	}
+
+	return slog.IntValue(len(b.Bytes))
+}
+
+// LogValue implements the slog.LogValuer interface. The value is logged as a
+// string.
+func (s String) LogValue() slog.Value {
+	if !s.Valid {
+		return nullLogValue
+	}
+
+	return slog.StringValue(s.String)
+}
+
+// LogValue implements the slog.LogValuer interface. The value is logged as an
+// int64.
+func (i Int64) LogValue() slog.Value {
+	if !i.Valid {
+		return nullLogValue
+	}
+
+	return slog.Int64Value(i.Int64)
+}
+
+// LogValue implements the slog.LogValuer interface. The value is logged as an
+// int64.
+func (i Int32) LogValue() slog.Value {
+	if !i.Valid {
+		return nullLogValue
+	}
+
+	return slog.Int64Value(int64(i.Int32))
+}
+
+// LogValue implements the slog.LogValuer interface. The value is logged as an
+// int64.
+func (i Int16) LogValue() slog.Value {
+	if !i.Valid {
+		return nullLogValue
+	}
+
+	return slog.Int64Value(int64(i.Int16))
+}
+
+// LogValue implements the slog.LogValuer interface. The value is logged as a
+// uint64.
+func (b Byte) LogValue() slog.Value {
+	if !b.Valid {
+		return nullLogValue
+	}
+
+	return slog.Uint64Value(uint64(b.Byte))
+}
+
+// LogValue implements the slog.LogValuer interface. The value is logged as a
+// time.Time.
+func (t Time) LogValue() slog.Value {
+	if !t.Valid {
+		return nullLogValue
+	}
+
+	return slog.TimeValue(t.Time)
+}
+
+// LogValue implements the slog.LogValuer interface. The value is logged as
+// its HexString(); see BinaryLength for logging only its length.
+func (b Binary) LogValue() slog.Value {
+	if !b.Valid {
+		return nullLogValue
+	}
+
+	return slog.StringValue(b.HexString())
+}
+
+// LogValue implements the slog.LogValuer interface. The plaintext is never
+// logged: a valid value is logged as [REDACTED].
+func (e Encrypted[T]) LogValue() slog.Value {
+	if !e.Valid {
+		return nullLogValue
+	}
+
+	return slog.StringValue(redacted)
+}
+
+// LogValue implements the slog.LogValuer interface like Int64.LogValue.
+func (q QuotedInt64) LogValue() slog.Value {
+	return Int64(q).LogValue()
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestLogValue(t *testing.T) {
+	date := time.Date(2012, 12, 12, 12, 12, 12, 0, time.UTC)
+
+	testCases := []struct {
+		label    string
+		subject  slog.LogValuer
+		wantKind slog.Kind
+		want     any
+	}{
+		{"with String", NewString("hello"), slog.KindString, "hello"},
+		{"with Int64", NewInt64(-1), slog.KindInt64, int64(-1)},
+		{"with Int32", NewInt32(-1), slog.KindInt64, int64(-1)},
+		{"with Int16", NewInt16(-1), slog.KindInt64, int64(-1)},
+		{"with Byte", NewByte(1), slog.KindUint64, uint64(1)},
+		{"with Time", NewTime(date), slog.KindTime, date},
+		{"with Binary", NewBinary([]byte("hi")), slog.KindString, "6869"},
+		{"with Encrypted", NewEncrypted("jane@example.com"), slog.KindString, "[REDACTED]"},
+		{"with NULL String", String{String: "stale"}, slog.KindAny, nil},
+		{"with NULL Int64", Int64{Int64: 1}, slog.KindAny, nil},
+		{"with NULL Int32", Int32{}, slog.KindAny, nil},
+		{"with NULL Int16", Int16{}, slog.KindAny, nil},
+		{"with NULL Byte", Byte{}, slog.KindAny, nil},
+		{"with NULL Time", Time{Time: date}, slog.KindAny, nil},
+		{"with NULL Binary", Binary{Bytes: []byte("stale")}, slog.KindAny, nil},
+		{"with NULL Encrypted", Encrypted[[]byte]{V: []byte("stale")}, slog.KindAny, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res := tc.subject.LogValue()
+
+			if res.Kind() != tc.wantKind {
+				t.Errorf("got: %v, want: %v", res.Kind(), tc.wantKind)
+				return
+			}
+			if res.Any() != tc.want {
+				t.Errorf("got: %v, want: %v", res.Any(), tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestReplaceNullAttr(t *testing.T) {
+	var nulled, plain bytes.Buffer
+
+	opts := &slog.HandlerOptions{ReplaceAttr: ReplaceNullAttr(slog.StringValue("NULL"))}
+	slog.New(slog.NewTextHandler(&nulled, opts)).Info("row", "id", Int64{}, "name", NewString("Jane"))
+	slog.New(slog.NewTextHandler(&plain, nil)).Info("row", "id", Int64{})
+
+	if want := "id=NULL name=Jane"; !bytes.Contains(nulled.Bytes(), []byte(want)) {
+		t.Errorf("got: %s, want: %s", nulled.String(), want)
+		return
+	}
+	if want := "id=<nil>"; !bytes.Contains(plain.Bytes(), []byte(want)) {
+		t.Errorf("got: %s, want: %s", plain.String(), want)
+		return
+	}
+}
+
+func TestBinaryLengthLogValue(t *testing.T) {
+	if res := BinaryLength(NewBinary([]byte("hello"))).LogValue(); res.Kind() != slog.KindInt64 || res.Int64() != 5 {
+		t.Errorf("got: %v, want: %v", res, 5)
+		return
+	}
+	if res := BinaryLength(Binary{Bytes: []byte("stale")}).LogValue(); res.Any() != nil {
+		t.Errorf("got: %v, want: %v", res, nil)
+		return
+	}
+}
+
+func TestLogValueHandler(t *testing.T) {
+	var buf bytes.Buffer
+
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("row", "id", NewInt64(1), "name", String{}, "blob", NewBinary([]byte("hi")), "email", NewEncrypted("jane@example.com"))
+
+	want := `"id":1,"name":null,"blob":"6869","email":"[REDACTED]"}`
+	if res := buf.String(); !bytes.Contains(buf.Bytes(), []byte(want)) {
+		t.Errorf("got: %v, want: %v", res, want)
+		return
+	}
+}
@@ -74,12 +74,41 @@ func (s String) Nil() bool {
 	return s.Null()
 }
 
+// Equal reports whether s and other hold the same value. All NULL values are
+// equal to each other regardless of any stale payload, and NULL is never
+// equal to a non-NULL value.
+func (s String) Equal(other String) bool {
+	if !s.Valid || !other.Valid {
+		return s.Valid == other.Valid
+	}
+
+	return s.String == other.String
+}
+
+// Normalize zeroes the payload of a NULL value, so that it compares equal to
+// any other NULL value with ==.
+func (s *String) Normalize() {
+	if !s.Valid {
+		*s = String{}
+	}
+}
+
 // Empty returns true if the underlying value is either NULL or an empty string.
 // Use the Null() function if you want to test specifically for NULL.
 func (s String) Empty() bool {
 	return !s.Valid || len(s.String) == 0
 }
 
+// Presence returns the value itself if it is Present(), and NULL otherwise,
+// so that an empty string can be stored as NULL in one call.
+func (s String) Presence() String {
+	if !s.Present() {
+		return String{}
+	}
+
+	return s
+}
+
 // HexString returns a hexadecimal string representation of the underlying value.
 func (s String) HexString() string {
 	var src string
@@ -197,3 +197,70 @@ func TestStringHexString(t *testing.T) {
 		})
 	}
 }
+
+func TestStringPresence(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject String
+		want    String
+	}{
+		{"with NULL string", String{String: "stale"}, String{}},
+		{"with empty string", NewString(""), String{}},
+		{"with non-empty string", NewString("hello"), NewString("hello")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.Presence(); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestStringEqual(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject String
+		other   String
+		want    bool
+	}{
+		{"with both NULL", String{}, String{}, true},
+		{"with both NULL + stale strings", String{String: "a"}, String{String: "b"}, true},
+		{"with NULL and empty string", String{}, NewString(""), false},
+		{"with same strings", NewString("a"), NewString("a"), true},
+		{"with different strings", NewString("a"), NewString("b"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.Equal(tc.other); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestStringNormalize(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject String
+		want    String
+	}{
+		{"with NULL string", String{String: "stale"}, String{}},
+		{"with non-empty string", NewString("hello"), NewString("hello")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			tc.subject.Normalize()
+
+			if tc.subject != tc.want {
+				t.Errorf("got: %v, want: %v", tc.subject, tc.want)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"encoding/base64"
+	"strconv"
+	"time"
+)
+
+// The methods in this file convert between the nullable types and their
+// canonical text form, which is shared by the encodings that represent
+// values as text. A NULL value has no text form, and setNull() is how a
+// decoder stores NULL.
+
+// texter is implemented by the nullable types that have a text form.
+type texter interface {
+	text() (string, bool)
+}
+
+// textParser is implemented by pointers to the nullable types that have a
+// text form.
+type textParser interface {
+	parseText(text string) error
+	setNull()
+}
+
+func (s String) text() (string, bool) {
+	if !s.Valid {
+		return "", false
+	}
+
+	return s.String, true
+}
+
+func (s *String) parseText(text string) error {
+	*s = NewString(text)
+	return nil
+}
+
+func (s *String) setNull() {
+	*s = String{}
+}
+
+func (i Int64) text() (string, bool) {
+	if !i.Valid {
+		return "", false
+	}
+
+	return strconv.FormatInt(i.Int64, 10), true
+}
+
+func (i *Int64) parseText(text string) error {
+	v, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return err
+	}
+	*i = NewInt64(v)
+
+	return nil
+}
+
+func (i *Int64) setNull() {
+	*i = Int64{}
+}
+
+func (i Int32) text() (string, bool) {
+	if !i.Valid {
+		return "", false
+	}
+
+	return strconv.FormatInt(int64(i.Int32), 10), true
+}
+
+func (i *Int32) parseText(text string) error {
+	v, err := strconv.ParseInt(text, 10, 32)
+	if err != nil {
+		return err
+	}
+	*i = NewInt32(int32(v))
+
+	return nil
+}
+
+func (i *Int32) setNull() {
+	*i = Int32{}
+}
+
+func (i Int16) text() (string, bool) {
+	if !i.Valid {
+		return "", false
+	}
+
+	return strconv.FormatInt(int64(i.Int16), 10), true
+}
+
+func (i *Int16) parseText(text string) error {
+	v, err := strconv.ParseInt(text, 10, 16)
+	if err != nil {
+		return err
+	}
+	*i = NewInt16(int16(v))
+
+	return nil
+}
+
+func (i *Int16) setNull() {
+	*i = Int16{}
+}
+
+func (b Byte) text() (string, bool) {
+	if !b.Valid {
+		return "", false
+	}
+
+	return strconv.FormatUint(uint64(b.Byte), 10), true
+}
+
+func (b *Byte) parseText(text string) error {
+	v, err := strconv.ParseUint(text, 10, 8)
+	if err != nil {
+		return err
+	}
+	*b = NewByte(byte(v))
+
+	return nil
+}
+
+func (b *Byte) setNull() {
+	*b = Byte{}
+}
+
+func (t Time) text() (string, bool) {
+	if !t.Valid {
+		return "", false
+	}
+
+	return t.Time.Format(time.RFC3339Nano), true
+}
+
+func (t *Time) parseText(text string) error {
+	v, err := time.Parse(time.RFC3339Nano, text)
+	if err != nil {
+		return err
+	}
+	*t = NewTime(v)
+
+	return nil
+}
+
+func (t *Time) setNull() {
+	*t = Time{}
+}
+
+func (b Binary) text() (string, bool) {
+	if !b.Valid {
+		return "", false
+	}
+
+	return base64.StdEncoding.EncodeToString(b.Bytes), true
+}
+
+func (b *Binary) parseText(text string) error {
+	v, err := base64.StdEncoding.DecodeString(text)
+	if err != nil {
+		return err
+	}
+	*b = NewBinary(v)
+
+	return nil
+}
+
+func (b *Binary) setNull() {
+	*b = Binary{}
+}
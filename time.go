@@ -60,3 +60,22 @@ func (t Time) Null() bool {
 func (t Time) Nil() bool {
 	return t.Null()
 }
+
+// Equal reports whether t and other hold the same instant. All NULL values are
+// equal to each other regardless of any stale payload, and NULL is never
+// equal to a non-NULL value.
+func (t Time) Equal(other Time) bool {
+	if !t.Valid || !other.Valid {
+		return t.Valid == other.Valid
+	}
+
+	return t.Time.Equal(other.Time)
+}
+
+// Normalize zeroes the payload of a NULL value, so that it compares equal to
+// any other NULL value with ==.
+func (t *Time) Normalize() {
+	if !t.Valid {
+		*t = Time{}
+	}
+}
@@ -78,3 +78,56 @@ func TestTimeNull(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeEqual(t *testing.T) {
+	now := time.Now()
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	testCases := []struct {
+		label   string
+		subject Time
+		other   Time
+		want    bool
+	}{
+		{"with both NULL", Time{}, Time{}, true},
+		{"with both NULL + stale times", Time{Time: now}, Time{}, true},
+		{"with NULL and zero time", Time{}, NewTime(time.Time{}), false},
+		{"with same times", NewTime(now), NewTime(now), true},
+		{"with same instant in another location", NewTime(now), NewTime(now.In(tokyo)), true},
+		{"with monotonic reading stripped", NewTime(now), NewTime(now.Round(0)), true},
+		{"with different times", NewTime(now), NewTime(now.Add(1)), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			if res := tc.subject.Equal(tc.other); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestTimeNormalize(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		label   string
+		subject Time
+		want    Time
+	}{
+		{"with NULL time", Time{Time: now}, Time{}},
+		{"with non-NULL time", NewTime(now), NewTime(now)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			tc.subject.Normalize()
+
+			if tc.subject != tc.want {
+				t.Errorf("got: %v, want: %v", tc.subject, tc.want)
+				return
+			}
+		})
+	}
+}
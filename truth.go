@@ -0,0 +1,332 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"cmp"
+	"strings"
+)
+
+// Truth is a truth value of SQL's three-valued logic, in which comparing
+// against NULL yields Unknown rather than true or false. The zero value is
+// Unknown.
+type Truth uint8
+
+// The truth values of three-valued logic.
+const (
+	Unknown Truth = iota
+	False
+	True
+)
+
+// TruthOf converts a bool into True or False.
+func TruthOf(b bool) Truth {
+	if b {
+		return True
+	}
+
+	return False
+}
+
+// And returns the logical conjunction of t and other. False wins over
+// Unknown, so False AND Unknown is False.
+func (t Truth) And(other Truth) Truth {
+	switch {
+	case t == False || other == False:
+		return False
+	case t == Unknown || other == Unknown:
+		return Unknown
+	}
+
+	return True
+}
+
+// Or returns the logical disjunction of t and other. True wins over Unknown,
+// so True OR Unknown is True.
+func (t Truth) Or(other Truth) Truth {
+	switch {
+	case t == True || other == True:
+		return True
+	case t == Unknown || other == Unknown:
+		return Unknown
+	}
+
+	return False
+}
+
+// Not returns the logical negation of t. The negation of Unknown is Unknown.
+func (t Truth) Not() Truth {
+	switch t {
+	case True:
+		return False
+	case False:
+		return True
+	}
+
+	return Unknown
+}
+
+// Bool returns true only if t is True, which is how a WHERE clause treats the
+// result of its condition.
+func (t Truth) Bool() bool {
+	return t == True
+}
+
+// String implements the fmt.Stringer interface.
+func (t Truth) String() string {
+	switch t {
+	case True:
+		return "TRUE"
+	case False:
+		return "FALSE"
+	}
+
+	return "UNKNOWN"
+}
+
+// compareTruth applies pred to the result c of comparing two values, or
+// returns Unknown if either value is NULL.
+func compareTruth(aValid, bValid bool, c int, pred func(c int) bool) Truth {
+	if !aValid || !bValid {
+		return Unknown
+	}
+
+	return TruthOf(pred(c))
+}
+
+func eq(c int) bool { return c == 0 }
+func ne(c int) bool { return c != 0 }
+func lt(c int) bool { return c < 0 }
+func le(c int) bool { return c <= 0 }
+func gt(c int) bool { return c > 0 }
+func ge(c int) bool { return c >= 0 }
+
+// Eq reports whether s is equal to other, as the SQL = operator does. The
+// result is Unknown if either value is NULL. Strings are compared byte-wise,
+// which may differ from the collation of the database.
+func (s String) Eq(other String) Truth {
+	return compareTruth(s.Valid, other.Valid, strings.Compare(s.String, other.String), eq)
+}
+
+// Ne reports whether s is not equal to other, or Unknown if either value is
+// NULL.
+func (s String) Ne(other String) Truth {
+	return compareTruth(s.Valid, other.Valid, strings.Compare(s.String, other.String), ne)
+}
+
+// Lt reports whether s is less than other, or Unknown if either value is NULL.
+func (s String) Lt(other String) Truth {
+	return compareTruth(s.Valid, other.Valid, strings.Compare(s.String, other.String), lt)
+}
+
+// Le reports whether s is less than or equal to other, or Unknown if either
+// value is NULL.
+func (s String) Le(other String) Truth {
+	return compareTruth(s.Valid, other.Valid, strings.Compare(s.String, other.String), le)
+}
+
+// Gt reports whether s is greater than other, or Unknown if either value is
+// NULL.
+func (s String) Gt(other String) Truth {
+	return compareTruth(s.Valid, other.Valid, strings.Compare(s.String, other.String), gt)
+}
+
+// Ge reports whether s is greater than or equal to other, or Unknown if either
+// value is NULL.
+func (s String) Ge(other String) Truth {
+	return compareTruth(s.Valid, other.Valid, strings.Compare(s.String, other.String), ge)
+}
+
+// Eq reports whether i is equal to other, as the SQL = operator does. The
+// result is Unknown if either value is NULL.
+func (i Int64) Eq(other Int64) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int64, other.Int64), eq)
+}
+
+// Ne reports whether i is not equal to other, or Unknown if either value is
+// NULL.
+func (i Int64) Ne(other Int64) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int64, other.Int64), ne)
+}
+
+// Lt reports whether i is less than other, or Unknown if either value is NULL.
+func (i Int64) Lt(other Int64) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int64, other.Int64), lt)
+}
+
+// Le reports whether i is less than or equal to other, or Unknown if either
+// value is NULL.
+func (i Int64) Le(other Int64) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int64, other.Int64), le)
+}
+
+// Gt reports whether i is greater than other, or Unknown if either value is
+// NULL.
+func (i Int64) Gt(other Int64) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int64, other.Int64), gt)
+}
+
+// Ge reports whether i is greater than or equal to other, or Unknown if either
+// value is NULL.
+func (i Int64) Ge(other Int64) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int64, other.Int64), ge)
+}
+
+// Eq reports whether i is equal to other, as the SQL = operator does. The
+// result is Unknown if either value is NULL.
+func (i Int32) Eq(other Int32) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int32, other.Int32), eq)
+}
+
+// Ne reports whether i is not equal to other, or Unknown if either value is
+// NULL.
+func (i Int32) Ne(other Int32) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int32, other.Int32), ne)
+}
+
+// Lt reports whether i is less than other, or Unknown if either value is NULL.
+func (i Int32) Lt(other Int32) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int32, other.Int32), lt)
+}
+
+// Le reports whether i is less than or equal to other, or Unknown if either
+// value is NULL.
+func (i Int32) Le(other Int32) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int32, other.Int32), le)
+}
+
+// Gt reports whether i is greater than other, or Unknown if either value is
+// NULL.
+func (i Int32) Gt(other Int32) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int32, other.Int32), gt)
+}
+
+// Ge reports whether i is greater than or equal to other, or Unknown if either
+// value is NULL.
+func (i Int32) Ge(other Int32) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int32, other.Int32), ge)
+}
+
+// Eq reports whether i is equal to other, as the SQL = operator does. The
+// result is Unknown if either value is NULL.
+func (i Int16) Eq(other Int16) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int16, other.Int16), eq)
+}
+
+// Ne reports whether i is not equal to other, or Unknown if either value is
+// NULL.
+func (i Int16) Ne(other Int16) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int16, other.Int16), ne)
+}
+
+// Lt reports whether i is less than other, or Unknown if either value is NULL.
+func (i Int16) Lt(other Int16) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int16, other.Int16), lt)
+}
+
+// Le reports whether i is less than or equal to other, or Unknown if either
+// value is NULL.
+func (i Int16) Le(other Int16) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int16, other.Int16), le)
+}
+
+// Gt reports whether i is greater than other, or Unknown if either value is
+// NULL.
+func (i Int16) Gt(other Int16) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int16, other.Int16), gt)
+}
+
+// Ge reports whether i is greater than or equal to other, or Unknown if either
+// value is NULL.
+func (i Int16) Ge(other Int16) Truth {
+	return compareTruth(i.Valid, other.Valid, cmp.Compare(i.Int16, other.Int16), ge)
+}
+
+// Eq reports whether b is equal to other, as the SQL = operator does. The
+// result is Unknown if either value is NULL.
+func (b Byte) Eq(other Byte) Truth {
+	return compareTruth(b.Valid, other.Valid, cmp.Compare(b.Byte, other.Byte), eq)
+}
+
+// Ne reports whether b is not equal to other, or Unknown if either value is
+// NULL.
+func (b Byte) Ne(other Byte) Truth {
+	return compareTruth(b.Valid, other.Valid, cmp.Compare(b.Byte, other.Byte), ne)
+}
+
+// Lt reports whether b is less than other, or Unknown if either value is NULL.
+func (b Byte) Lt(other Byte) Truth {
+	return compareTruth(b.Valid, other.Valid, cmp.Compare(b.Byte, other.Byte), lt)
+}
+
+// Le reports whether b is less than or equal to other, or Unknown if either
+// value is NULL.
+func (b Byte) Le(other Byte) Truth {
+	return compareTruth(b.Valid, other.Valid, cmp.Compare(b.Byte, other.Byte), le)
+}
+
+// Gt reports whether b is greater than other, or Unknown if either value is
+// NULL.
+func (b Byte) Gt(other Byte) Truth {
+	return compareTruth(b.Valid, other.Valid, cmp.Compare(b.Byte, other.Byte), gt)
+}
+
+// Ge reports whether b is greater than or equal to other, or Unknown if either
+// value is NULL.
+func (b Byte) Ge(other Byte) Truth {
+	return compareTruth(b.Valid, other.Valid, cmp.Compare(b.Byte, other.Byte), ge)
+}
+
+// Eq reports whether t is equal to other, as the SQL = operator does. The
+// result is Unknown if either value is NULL. Times are compared as instants,
+// regardless of their location.
+func (t Time) Eq(other Time) Truth {
+	return compareTruth(t.Valid, other.Valid, t.Time.Compare(other.Time), eq)
+}
+
+// Ne reports whether t is not equal to other, or Unknown if either value is
+// NULL.
+func (t Time) Ne(other Time) Truth {
+	return compareTruth(t.Valid, other.Valid, t.Time.Compare(other.Time), ne)
+}
+
+// Lt reports whether t is less than other, or Unknown if either value is NULL.
+func (t Time) Lt(other Time) Truth {
+	return compareTruth(t.Valid, other.Valid, t.Time.Compare(other.Time), lt)
+}
+
+// Le reports whether t is less than or equal to other, or Unknown if either
+// value is NULL.
+func (t Time) Le(other Time) Truth {
+	return compareTruth(t.Valid, other.Valid, t.Time.Compare(other.Time), le)
+}
+
+// Gt reports whether t is greater than other, or Unknown if either value is
+// NULL.
+func (t Time) Gt(other Time) Truth {
+	return compareTruth(t.Valid, other.Valid, t.Time.Compare(other.Time), gt)
+}
+
+// Ge reports whether t is greater than or equal to other, or Unknown if either
+// value is NULL.
+func (t Time) Ge(other Time) Truth {
+	return compareTruth(t.Valid, other.Valid, t.Time.Compare(other.Time), ge)
+}
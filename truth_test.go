@@ -0,0 +1,225 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTruthLogic(t *testing.T) {
+	testCases := []struct {
+		a, b    Truth
+		wantAnd Truth
+		wantOr  Truth
+	}{
+		{True, True, True, True},
+		{True, False, False, True},
+		{True, Unknown, Unknown, True},
+		{False, True, False, True},
+		{False, False, False, False},
+		{False, Unknown, False, Unknown},
+		{Unknown, True, Unknown, True},
+		{Unknown, False, False, Unknown},
+		{Unknown, Unknown, Unknown, Unknown},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a.String()+" "+tc.b.String(), func(t *testing.T) {
+			if res := tc.a.And(tc.b); res != tc.wantAnd {
+				t.Errorf("got: %v, want: %v", res, tc.wantAnd)
+				return
+			}
+			if res := tc.a.Or(tc.b); res != tc.wantOr {
+				t.Errorf("got: %v, want: %v", res, tc.wantOr)
+				return
+			}
+		})
+	}
+}
+
+func TestTruthNot(t *testing.T) {
+	testCases := []struct {
+		subject  Truth
+		want     Truth
+		wantBool bool
+	}{
+		{True, False, true},
+		{False, True, false},
+		{Unknown, Unknown, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.subject.String(), func(t *testing.T) {
+			if res := tc.subject.Not(); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+			if res := tc.subject.Bool(); res != tc.wantBool {
+				t.Errorf("got: %v, want: %v", res, tc.wantBool)
+				return
+			}
+		})
+	}
+}
+
+func TestTruthOf(t *testing.T) {
+	if res := TruthOf(true); res != True {
+		t.Errorf("got: %v, want: %v", res, True)
+		return
+	}
+	if res := TruthOf(false); res != False {
+		t.Errorf("got: %v, want: %v", res, False)
+		return
+	}
+
+	var zero Truth
+	if zero != Unknown {
+		t.Errorf("got: %v, want: %v", zero, Unknown)
+		return
+	}
+}
+
+// comparisons collects the results of Eq, Ne, Lt, Le, Gt and Ge in order.
+type comparisons [6]Truth
+
+var (
+	unknownComparisons = comparisons{Unknown, Unknown, Unknown, Unknown, Unknown, Unknown}
+	equalComparisons   = comparisons{True, False, False, True, False, True}
+	lessComparisons    = comparisons{False, True, True, True, False, False}
+	greaterComparisons = comparisons{False, True, False, False, True, True}
+)
+
+func TestStringComparisons(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject String
+		other   String
+		want    comparisons
+	}{
+		{"with both NULL", String{}, String{}, unknownComparisons},
+		{"with NULL and value", String{}, NewString(""), unknownComparisons},
+		{"with value and NULL", NewString("a"), String{String: "a"}, unknownComparisons},
+		{"with equal values", NewString("a"), NewString("a"), equalComparisons},
+		{"with lesser value", NewString("a"), NewString("b"), lessComparisons},
+		{"with greater value", NewString("b"), NewString(""), greaterComparisons},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			s, o := tc.subject, tc.other
+			if res := (comparisons{s.Eq(o), s.Ne(o), s.Lt(o), s.Le(o), s.Gt(o), s.Ge(o)}); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestIntComparisons(t *testing.T) {
+	testCases := []struct {
+		label string
+		a, b  int16
+		valid bool
+		want  comparisons
+	}{
+		{"with NULL", 1, 1, false, unknownComparisons},
+		{"with equal values", 1, 1, true, equalComparisons},
+		{"with lesser value", -1, 1, true, lessComparisons},
+		{"with greater value", 1, 0, true, greaterComparisons},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			i64, o64 := Int64{Int64: int64(tc.a), Valid: tc.valid}, NewInt64(int64(tc.b))
+			if res := (comparisons{i64.Eq(o64), i64.Ne(o64), i64.Lt(o64), i64.Le(o64), i64.Gt(o64), i64.Ge(o64)}); res != tc.want {
+				t.Errorf("Int64 got: %v, want: %v", res, tc.want)
+				return
+			}
+
+			i32, o32 := NewInt32(int32(tc.a)), Int32{Int32: int32(tc.b), Valid: tc.valid}
+			if res := (comparisons{i32.Eq(o32), i32.Ne(o32), i32.Lt(o32), i32.Le(o32), i32.Gt(o32), i32.Ge(o32)}); res != tc.want {
+				t.Errorf("Int32 got: %v, want: %v", res, tc.want)
+				return
+			}
+
+			i16, o16 := Int16{Int16: tc.a, Valid: tc.valid}, NewInt16(tc.b)
+			if res := (comparisons{i16.Eq(o16), i16.Ne(o16), i16.Lt(o16), i16.Le(o16), i16.Gt(o16), i16.Ge(o16)}); res != tc.want {
+				t.Errorf("Int16 got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestByteComparisons(t *testing.T) {
+	testCases := []struct {
+		label   string
+		subject Byte
+		other   Byte
+		want    comparisons
+	}{
+		{"with both NULL", Byte{}, Byte{}, unknownComparisons},
+		{"with NULL and value", Byte{}, NewByte(0), unknownComparisons},
+		{"with equal values", NewByte(1), NewByte(1), equalComparisons},
+		{"with lesser value", NewByte(1), NewByte(255), lessComparisons},
+		{"with greater value", NewByte(1), NewByte(0), greaterComparisons},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			b, o := tc.subject, tc.other
+			if res := (comparisons{b.Eq(o), b.Ne(o), b.Lt(o), b.Le(o), b.Gt(o), b.Ge(o)}); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestTimeComparisons(t *testing.T) {
+	now := time.Date(2012, 12, 12, 12, 12, 12, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	testCases := []struct {
+		label   string
+		subject Time
+		other   Time
+		want    comparisons
+	}{
+		{"with both NULL", Time{}, Time{}, unknownComparisons},
+		{"with value and NULL", NewTime(now), Time{Time: now}, unknownComparisons},
+		{"with equal values", NewTime(now), NewTime(now), equalComparisons},
+		{"with equal instants", NewTime(now), NewTime(now.In(tokyo)), equalComparisons},
+		{"with lesser value", NewTime(now), NewTime(now.Add(time.Second)), lessComparisons},
+		{"with greater value", NewTime(now), NewTime(now.Add(-time.Second)), greaterComparisons},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			s, o := tc.subject, tc.other
+			if res := (comparisons{s.Eq(o), s.Ne(o), s.Lt(o), s.Le(o), s.Gt(o), s.Ge(o)}); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"encoding/xml"
+	"io"
+	"runtime"
+	"sync"
+	"weak"
+)
+
+// xsiNamespace is the namespace of the xsi:nil attribute.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// XMLNullMode controls how NULL values are marshaled as XML elements.
+type XMLNullMode int
+
+const (
+	// XMLNullOmit leaves the element of a NULL value out of the document.
+	XMLNullOmit XMLNullMode = iota
+
+	// XMLNullNil marks the element of a NULL value with xsi:nil="true".
+	XMLNullNil
+)
+
+// xmlNullModes maps the encoders created by NewXMLEncoder to their
+// XMLNullMode. The encoders are held weakly, and their entries are removed
+// once they are garbage collected.
+var xmlNullModes sync.Map
+
+// NewXMLEncoder returns an xml.Encoder that writes to w and marshals NULL
+// elements according to null. Encoders created by xml.NewEncoder, as well as
+// xml.Marshal, use XMLNullOmit. NULL attributes are always omitted, and
+// decoding honors xsi:nil and treats an absent element or attribute as NULL
+// regardless of the mode. An empty element such as <age></age> decodes to NULL
+// too, except for a String or Binary, where it decodes to an empty value.
+func NewXMLEncoder(w io.Writer, null XMLNullMode) *xml.Encoder {
+	e := xml.NewEncoder(w)
+
+	key := weak.Make(e)
+	xmlNullModes.Store(key, null)
+	runtime.AddCleanup(e, func(key weak.Pointer[xml.Encoder]) {
+		xmlNullModes.Delete(key)
+	}, key)
+
+	return e
+}
+
+// xmlNullMode returns the XMLNullMode of the encoder e.
+func xmlNullMode(e *xml.Encoder) XMLNullMode {
+	if mode, ok := xmlNullModes.Load(weak.Make(e)); ok {
+		return mode.(XMLNullMode)
+	}
+
+	return XMLNullOmit
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (s String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, s)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (s *String) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, s)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (s String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, s)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (s *String) UnmarshalXMLAttr(attr xml.Attr) error {
+	return s.parseText(attr.Value)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (i Int64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (i *Int64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (i Int64) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (i *Int64) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.parseText(attr.Value)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (i Int32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (i *Int32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (i Int32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (i *Int32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.parseText(attr.Value)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (i Int16) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (i *Int16) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (i Int16) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (i *Int16) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.parseText(attr.Value)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (b Byte) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (b *Byte) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, b)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (b Byte) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (b *Byte) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.parseText(attr.Value)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, t)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (t *Time) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, t)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.parseText(attr.Value)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (b Binary) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (b *Binary) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, b)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (b Binary) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (b *Binary) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.parseText(attr.Value)
+}
+
+func marshalXML(e *xml.Encoder, start xml.StartElement, v texter) error {
+	text, ok := v.text()
+	if ok {
+		return e.EncodeElement(text, start)
+	}
+	if xmlNullMode(e) != XMLNullNil {
+		return nil
+	}
+
+	start.Attr = append(start.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+	)
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func unmarshalXML(d *xml.Decoder, start xml.StartElement, v textParser) error {
+	for _, attr := range start.Attr {
+		// The decoder resolves the xsi prefix to its namespace when it is
+		// declared, but leaves it as is otherwise.
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") &&
+			(attr.Value == "true" || attr.Value == "1") {
+			v.setNull()
+			return d.Skip()
+		}
+	}
+
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+
+	// An empty String or Binary is a valid value, but the other types have
+	// no empty text form, so an empty element of theirs is NULL.
+	if text == "" {
+		switch v.(type) {
+		case *String, *Binary:
+		default:
+			v.setNull()
+			return nil
+		}
+	}
+
+	return v.parseText(text)
+}
+
+func marshalXMLAttr(name xml.Name, v texter) (xml.Attr, error) {
+	text, ok := v.text()
+	if !ok {
+		return xml.Attr{}, nil
+	}
+
+	return xml.Attr{Name: name, Value: text}, nil
+}
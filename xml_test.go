@@ -0,0 +1,214 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type xmlRow struct {
+	XMLName xml.Name `xml:"row"`
+	ID      Int64    `xml:"id,attr"`
+	Name    String   `xml:"name"`
+	Age     Int16    `xml:"age"`
+	Rank    Int32    `xml:"rank"`
+	Flag    Byte     `xml:"flag"`
+	Born    Time     `xml:"born"`
+	Avatar  Binary   `xml:"avatar"`
+}
+
+func TestXMLMarshal(t *testing.T) {
+	date := time.Date(2012, 12, 12, 12, 12, 12, 0, time.UTC)
+	full := xmlRow{
+		ID:     NewInt64(1),
+		Name:   NewString("Jane"),
+		Age:    NewInt16(30),
+		Rank:   NewInt32(-2),
+		Flag:   NewByte(1),
+		Born:   NewTime(date),
+		Avatar: NewBinary([]byte("hi")),
+	}
+
+	testCases := []struct {
+		label   string
+		mode    XMLNullMode
+		subject xmlRow
+		want    string
+	}{
+		{
+			"with values",
+			XMLNullOmit,
+			full,
+			`<row id="1"><name>Jane</name><age>30</age><rank>-2</rank><flag>1</flag><born>2012-12-12T12:12:12Z</born><avatar>aGk=</avatar></row>`,
+		},
+		{
+			"with NULLs omitted",
+			XMLNullOmit,
+			xmlRow{Name: String{String: "stale"}, Age: NewInt16(0)},
+			`<row><age>0</age></row>`,
+		},
+		{
+			"with NULLs as xsi:nil",
+			XMLNullNil,
+			xmlRow{Name: NewString(""), Age: Int16{Int16: 1}},
+			`<row><name></name>` +
+				`<age xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></age>` +
+				`<rank xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></rank>` +
+				`<flag xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></flag>` +
+				`<born xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></born>` +
+				`<avatar xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></avatar></row>`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := NewXMLEncoder(&buf, tc.mode).Encode(tc.subject); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res := buf.String(); res != tc.want {
+				t.Errorf("got: %s, want: %s", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestXMLEncoderModes(t *testing.T) {
+	subject := xmlRow{Name: NewString("Jane")}
+
+	// Encoders with different modes can be used side by side, and neither
+	// affects xml.Marshal.
+	var omitted, nilled bytes.Buffer
+	omit, nilElements := NewXMLEncoder(&omitted, XMLNullOmit), NewXMLEncoder(&nilled, XMLNullNil)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			b, err := xml.Marshal(subject)
+			if err != nil || strings.Contains(string(b), "nil") {
+				t.Errorf("got: %s, %v, want NULLs omitted", b, err)
+			}
+		})
+	}
+	if err := omit.Encode(subject); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if err := nilElements.Encode(subject); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	wg.Wait()
+
+	if res := omitted.String(); strings.Contains(res, "nil") {
+		t.Errorf("got: %s, want NULLs omitted", res)
+		return
+	}
+	if res := nilled.String(); !strings.Contains(res, `<age xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></age>`) {
+		t.Errorf("got: %s, want NULLs as xsi:nil", res)
+		return
+	}
+}
+
+func TestXMLUnmarshal(t *testing.T) {
+	date := time.Date(2012, 12, 12, 12, 12, 12, 0, time.UTC)
+
+	testCases := []struct {
+		label   string
+		input   string
+		want    xmlRow
+		wantErr bool
+	}{
+		{
+			"with values",
+			`<row id="1"><name>Jane</name><age>30</age><rank>-2</rank><flag>1</flag><born>2012-12-12T12:12:12Z</born><avatar>aGk=</avatar></row>`,
+			xmlRow{
+				ID:     NewInt64(1),
+				Name:   NewString("Jane"),
+				Age:    NewInt16(30),
+				Rank:   NewInt32(-2),
+				Flag:   NewByte(1),
+				Born:   NewTime(date),
+				Avatar: NewBinary([]byte("hi")),
+			},
+			false,
+		},
+		{
+			"with absent elements",
+			`<row><name></name></row>`,
+			xmlRow{Name: NewString("")},
+			false,
+		},
+		{
+			"with empty elements",
+			`<row><name></name><age></age><rank/><flag></flag><born></born><avatar></avatar></row>`,
+			xmlRow{Name: NewString(""), Avatar: NewBinary([]byte{})},
+			false,
+		},
+		{
+			"with declared xsi:nil",
+			`<row xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><name xsi:nil="true"/><age xsi:nil="1"></age><rank xsi:nil="false">3</rank></row>`,
+			xmlRow{Rank: NewInt32(3)},
+			false,
+		},
+		{
+			"with undeclared xsi:nil",
+			`<row><name xsi:nil="true">ignored</name></row>`,
+			xmlRow{},
+			false,
+		},
+		{"with invalid integer", `<row><age>old</age></row>`, xmlRow{}, true},
+		{"with out of range integer", `<row><age>40000</age></row>`, xmlRow{}, true},
+		{"with invalid attribute", `<row id="x"></row>`, xmlRow{}, true},
+		{"with invalid time", `<row><born>yesterday</born></row>`, xmlRow{}, true},
+		{"with invalid binary", `<row><avatar>!</avatar></row>`, xmlRow{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			var res xmlRow
+
+			err := xml.Unmarshal([]byte(tc.input), &res)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if tc.wantErr {
+				return
+			}
+
+			res.XMLName = xml.Name{}
+			if res.ID != tc.want.ID || res.Name != tc.want.Name || res.Age != tc.want.Age ||
+				res.Rank != tc.want.Rank || res.Flag != tc.want.Flag || !res.Born.Equal(tc.want.Born) ||
+				!res.Avatar.Equal(tc.want.Avatar) {
+				t.Errorf("got: %+v, want: %+v", res, tc.want)
+				return
+			}
+		})
+	}
+}
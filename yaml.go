@@ -0,0 +1,219 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"time"
+)
+
+// The YAML methods match the shapes of the Marshaler and Unmarshaler
+// interfaces that the common YAML libraries look for, so that no YAML
+// package needs to be imported. NULL is marshaled as a null node, and both
+// ~ and null unmarshal to NULL.
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (s String) MarshalYAML() (any, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+
+	return s.String, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (s *String) UnmarshalYAML(unmarshal func(any) error) error {
+	return unmarshalYAML(unmarshal, s, func(v string) error {
+		return s.parseText(v)
+	})
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (i Int64) MarshalYAML() (any, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+
+	return i.Int64, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (i *Int64) UnmarshalYAML(unmarshal func(any) error) error {
+	return unmarshalYAML(unmarshal, i, func(v int64) error {
+		*i = NewInt64(v)
+		return nil
+	})
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (i Int32) MarshalYAML() (any, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+
+	return i.Int32, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (i *Int32) UnmarshalYAML(unmarshal func(any) error) error {
+	return unmarshalYAML(unmarshal, i, func(v int64) error {
+		if v < math.MinInt32 || v > math.MaxInt32 {
+			return fmt.Errorf("cannot unmarshal %d into int32: value out of range", v)
+		}
+		*i = NewInt32(int32(v))
+
+		return nil
+	})
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (i Int16) MarshalYAML() (any, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+
+	return i.Int16, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (i *Int16) UnmarshalYAML(unmarshal func(any) error) error {
+	return unmarshalYAML(unmarshal, i, func(v int64) error {
+		if v < math.MinInt16 || v > math.MaxInt16 {
+			return fmt.Errorf("cannot unmarshal %d into int16: value out of range", v)
+		}
+		*i = NewInt16(int16(v))
+
+		return nil
+	})
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (b Byte) MarshalYAML() (any, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+
+	return b.Byte, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (b *Byte) UnmarshalYAML(unmarshal func(any) error) error {
+	return unmarshalYAML(unmarshal, b, func(v int64) error {
+		if v < 0 || v > math.MaxUint8 {
+			return fmt.Errorf("cannot unmarshal %d into byte: value out of range", v)
+		}
+		*b = NewByte(byte(v))
+
+		return nil
+	})
+}
+
+// MarshalYAML implements the yaml.Marshaler interface. The value is marshaled
+// as a time.Time, which YAML libraries encode as a timestamp.
+func (t Time) MarshalYAML() (any, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+
+	return t.Time, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (t *Time) UnmarshalYAML(unmarshal func(any) error) error {
+	return unmarshalYAML(unmarshal, t, func(v time.Time) error {
+		*t = NewTime(v)
+		return nil
+	})
+}
+
+// MarshalYAML implements the yaml.Marshaler interface. The value is marshaled
+// as a base64 string.
+func (b Binary) MarshalYAML() (any, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+
+	return base64.StdEncoding.EncodeToString(b.Bytes), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface. The value must be
+// a base64 string.
+func (b *Binary) UnmarshalYAML(unmarshal func(any) error) error {
+	return unmarshalYAML(unmarshal, b, func(v string) error {
+		return b.parseText(v)
+	})
+}
+
+// MarshalYAML implements the yaml.Marshaler interface. The value is written
+// as the text of MarshalText, so the plaintext never appears in the output.
+func (e Encrypted[T]) MarshalYAML() (any, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+
+	text, err := e.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (e *Encrypted[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw any
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		*e = Encrypted[T]{}
+		return nil
+	}
+
+	var text string
+	if err := unmarshal(&text); err != nil {
+		return err
+	}
+
+	return e.UnmarshalText([]byte(text))
+}
+
+// unmarshalYAML decodes the node behind unmarshal into a V and hands it to
+// set, or sets dst to NULL if the node is null.
+func unmarshalYAML[V any](unmarshal func(any) error, dst textParser, set func(v V) error) error {
+	var raw any
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		dst.setNull()
+		return nil
+	}
+
+	var v V
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	return set(v)
+}
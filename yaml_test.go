@@ -0,0 +1,168 @@
+// Copyright 2026 Toru Maesaka
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+package nullable
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeYAML returns an unmarshal function like the one YAML libraries pass to
+// UnmarshalYAML, decoding node, which stands in for a parsed YAML scalar.
+func fakeYAML(node any) func(any) error {
+	return func(dst any) error {
+		target := reflect.ValueOf(dst).Elem()
+		if node == nil {
+			target.SetZero()
+			return nil
+		}
+
+		// Like a real decoder, refuse to turn strings into numbers and vice
+		// versa even though reflect could convert between them.
+		src := reflect.ValueOf(node)
+		switch {
+		case target.Kind() == reflect.Interface:
+			target.Set(src)
+		case (src.Kind() == reflect.String) != (target.Kind() == reflect.String),
+			!src.Type().ConvertibleTo(target.Type()):
+			return fmt.Errorf("cannot unmarshal %T into %s", node, target.Type())
+		default:
+			target.Set(src.Convert(target.Type()))
+		}
+
+		return nil
+	}
+}
+
+func TestYAMLMarshal(t *testing.T) {
+	date := time.Date(2012, 12, 12, 12, 12, 12, 0, time.UTC)
+
+	testCases := []struct {
+		label   string
+		subject interface{ MarshalYAML() (any, error) }
+		want    any
+	}{
+		{"with String", NewString("hello"), "hello"},
+		{"with Int64", NewInt64(-1), int64(-1)},
+		{"with Int32", NewInt32(-1), int32(-1)},
+		{"with Int16", NewInt16(-1), int16(-1)},
+		{"with Byte", NewByte(1), byte(1)},
+		{"with Time", NewTime(date), date},
+		{"with Binary", NewBinary([]byte("hi")), "aGk="},
+		{"with NULL String", String{String: "stale"}, nil},
+		{"with NULL Int64", Int64{}, nil},
+		{"with NULL Int32", Int32{}, nil},
+		{"with NULL Int16", Int16{}, nil},
+		{"with NULL Byte", Byte{}, nil},
+		{"with NULL Time", Time{}, nil},
+		{"with NULL Binary", Binary{Bytes: []byte("stale")}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			res, err := tc.subject.MarshalYAML()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestYAMLUnmarshal(t *testing.T) {
+	date := time.Date(2012, 12, 12, 12, 12, 12, 0, time.UTC)
+
+	type unmarshaler interface {
+		UnmarshalYAML(func(any) error) error
+		Null() bool
+	}
+
+	testCases := []struct {
+		label   string
+		subject unmarshaler
+		node    any
+		want    any
+		wantErr bool
+	}{
+		{"with String", &String{}, "hello", NewString("hello"), false},
+		{"with empty String", &String{}, "", NewString(""), false},
+		{"with NULL String", &String{String: "stale", Valid: true}, nil, String{}, false},
+		{"with Int64", &Int64{}, -1, NewInt64(-1), false},
+		{"with NULL Int64", &Int64{Int64: 1, Valid: true}, nil, Int64{}, false},
+		{"with invalid Int64", &Int64{}, "one", nil, true},
+		{"with Int32", &Int32{}, -1, NewInt32(-1), false},
+		{"with out of range Int32", &Int32{}, 1 << 31, nil, true},
+		{"with Int16", &Int16{}, -1, NewInt16(-1), false},
+		{"with out of range Int16", &Int16{}, -1<<15 - 1, nil, true},
+		{"with Byte", &Byte{}, 255, NewByte(255), false},
+		{"with out of range Byte", &Byte{}, 256, nil, true},
+		{"with negative Byte", &Byte{}, -1, nil, true},
+		{"with Time", &Time{}, date, NewTime(date), false},
+		{"with NULL Time", &Time{Time: date, Valid: true}, nil, Time{}, false},
+		{"with Binary", &Binary{}, "aGk=", nil, false},
+		{"with invalid Binary", &Binary{}, "!", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.label, func(t *testing.T) {
+			err := tc.subject.UnmarshalYAML(fakeYAML(tc.node))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("got: %v, want: %v", err, tc.wantErr)
+				return
+			}
+			if tc.want == nil {
+				return
+			}
+			if res := reflect.ValueOf(tc.subject).Elem().Interface(); res != tc.want {
+				t.Errorf("got: %v, want: %v", res, tc.want)
+				return
+			}
+		})
+	}
+}
+
+func TestYAMLUnmarshalBinary(t *testing.T) {
+	var b Binary
+
+	if err := b.UnmarshalYAML(fakeYAML("aGk=")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !b.Equal(NewBinary([]byte("hi"))) {
+		t.Errorf("got: %v, want: %v", b, "hi")
+		return
+	}
+
+	if err := b.UnmarshalYAML(fakeYAML(nil)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !b.Null() {
+		t.Errorf("got: %v, want: NULL", b)
+		return
+	}
+}